@@ -0,0 +1,350 @@
+package env
+
+import (
+	"net"
+	"net/netip"
+	"net/url"
+	"strconv"
+)
+
+// HostPort is a network address made of a host and a port, as returned by WithHostPort.
+type HostPort struct {
+	// Host is the host name or IP address, which may be empty to designate every interface, e.g. ":8080".
+	Host string
+	Port uint16
+}
+
+// String returns the address in the host:port form, as understood by net.Dial.
+func (hp HostPort) String() string {
+	return net.JoinHostPort(hp.Host, strconv.FormatUint(uint64(hp.Port), 10))
+}
+
+// WithURL retrieves the value of an environment variable identified by the key
+// and tries to parse it as an absolute URL, e.g. https://api.example.com. Opaque URLs such
+// as localhost:8080 are rejected, and only the schemes set with the Schemes option are
+// accepted, if any.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithURL(key string, opts ...Option) (*url.URL, error) {
+	return Get[*url.URL](key, opts...)
+}
+
+// WithHostPort retrieves the value of an environment variable identified by the key
+// and tries to parse it as a host:port pair, whose port must be a number between 0 and 65535.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithHostPort(key string, opts ...Option) (HostPort, error) {
+	return Get[HostPort](key, opts...)
+}
+
+// WithIP retrieves the value of an environment variable identified by the key
+// and tries to parse it as an IPv4 or IPv6 address.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithIP(key string, opts ...Option) (netip.Addr, error) {
+	return Get[netip.Addr](key, opts...)
+}
+
+// WithIPNet retrieves the value of an environment variable identified by the key
+// and tries to parse it as an IP network in CIDR notation, e.g. 10.0.0.0/8.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithIPNet(key string, opts ...Option) (netip.Prefix, error) {
+	return Get[netip.Prefix](key, opts...)
+}
+
+// WithURLSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of URL, the same way WithURL parses a single value. Elements
+// are separated by a comma, unless another separator is set with the Separator option, and trimmed
+// of their whitespaces. An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithURLSlice(key string, opts ...Option) ([]*url.URL, error) {
+	return Get[[]*url.URL](key, opts...)
+}
+
+// WithHostPortSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of HostPort, the same way WithHostPort parses a single value. Elements
+// are separated by a comma, unless another separator is set with the Separator option, and trimmed
+// of their whitespaces. An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithHostPortSlice(key string, opts ...Option) ([]HostPort, error) {
+	return Get[[]HostPort](key, opts...)
+}
+
+// WithIPSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of netip.Addr, the same way WithIP parses a single value. Elements
+// are separated by a comma, unless another separator is set with the Separator option, and trimmed
+// of their whitespaces. An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithIPSlice(key string, opts ...Option) ([]netip.Addr, error) {
+	return Get[[]netip.Addr](key, opts...)
+}
+
+// WithIPNetSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of netip.Prefix, the same way WithIPNet parses a single value. Elements
+// are separated by a comma, unless another separator is set with the Separator option, and trimmed
+// of their whitespaces. An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithIPNetSlice(key string, opts ...Option) ([]netip.Prefix, error) {
+	return Get[[]netip.Prefix](key, opts...)
+}
+
+// WithDefaultURL retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default URL provided.
+//
+// This method is a convenience wrapper around WithURL to silent the error and return a fallback value.
+func WithDefaultURL(key string, fallback *url.URL, opts ...Option) *url.URL {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultHostPort retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default HostPort provided.
+//
+// This method is a convenience wrapper around WithHostPort to silent the error and return a fallback value.
+func WithDefaultHostPort(key string, fallback HostPort, opts ...Option) HostPort {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultIP retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default netip.Addr provided.
+//
+// This method is a convenience wrapper around WithIP to silent the error and return a fallback value.
+func WithDefaultIP(key string, fallback netip.Addr, opts ...Option) netip.Addr {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultIPNet retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default netip.Prefix provided.
+//
+// This method is a convenience wrapper around WithIPNet to silent the error and return a fallback value.
+func WithDefaultIPNet(key string, fallback netip.Prefix, opts ...Option) netip.Prefix {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultURLSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []URL provided.
+//
+// This method is a convenience wrapper around WithURLSlice to silent the error and return a fallback value.
+func WithDefaultURLSlice(key string, fallback []*url.URL, opts ...Option) []*url.URL {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultHostPortSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []HostPort provided.
+//
+// This method is a convenience wrapper around WithHostPortSlice to silent the error and return a fallback value.
+func WithDefaultHostPortSlice(key string, fallback []HostPort, opts ...Option) []HostPort {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultIPSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []netip.Addr provided.
+//
+// This method is a convenience wrapper around WithIPSlice to silent the error and return a fallback value.
+func WithDefaultIPSlice(key string, fallback []netip.Addr, opts ...Option) []netip.Addr {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultIPNetSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []netip.Prefix provided.
+//
+// This method is a convenience wrapper around WithIPNetSlice to silent the error and return a fallback value.
+func WithDefaultIPNetSlice(key string, fallback []netip.Prefix, opts ...Option) []netip.Prefix {
+	return GetOr(key, fallback, opts...)
+}
+
+// MustURL is like WithURL but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustURL(key string, opts ...Option) *url.URL {
+	return Must(WithURL(key, opts...))
+}
+
+// MustHostPort is like WithHostPort but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustHostPort(key string, opts ...Option) HostPort {
+	return Must(WithHostPort(key, opts...))
+}
+
+// MustIP is like WithIP but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustIP(key string, opts ...Option) netip.Addr {
+	return Must(WithIP(key, opts...))
+}
+
+// MustIPNet is like WithIPNet but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustIPNet(key string, opts ...Option) netip.Prefix {
+	return Must(WithIPNet(key, opts...))
+}
+
+// MustURLSlice is like WithURLSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustURLSlice(key string, opts ...Option) []*url.URL {
+	return Must(WithURLSlice(key, opts...))
+}
+
+// MustHostPortSlice is like WithHostPortSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustHostPortSlice(key string, opts ...Option) []HostPort {
+	return Must(WithHostPortSlice(key, opts...))
+}
+
+// MustIPSlice is like WithIPSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustIPSlice(key string, opts ...Option) []netip.Addr {
+	return Must(WithIPSlice(key, opts...))
+}
+
+// MustIPNetSlice is like WithIPNetSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustIPNetSlice(key string, opts ...Option) []netip.Prefix {
+	return Must(WithIPNetSlice(key, opts...))
+}
+
+// WithURL retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a URL, the same way the package-level WithURL does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithURL(key string, opts ...Option) (*url.URL, error) {
+	return GetFrom[*url.URL](l, key, opts...)
+}
+
+// WithHostPort retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a HostPort, the same way the package-level WithHostPort does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithHostPort(key string, opts ...Option) (HostPort, error) {
+	return GetFrom[HostPort](l, key, opts...)
+}
+
+// WithIP retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a netip.Addr, the same way the package-level WithIP does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithIP(key string, opts ...Option) (netip.Addr, error) {
+	return GetFrom[netip.Addr](l, key, opts...)
+}
+
+// WithIPNet retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a netip.Prefix, the same way the package-level WithIPNet does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithIPNet(key string, opts ...Option) (netip.Prefix, error) {
+	return GetFrom[netip.Prefix](l, key, opts...)
+}
+
+// WithURLSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of URL, the same way the package-level WithURLSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithURLSlice(key string, opts ...Option) ([]*url.URL, error) {
+	return GetFrom[[]*url.URL](l, key, opts...)
+}
+
+// WithHostPortSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of HostPort, the same way the package-level WithHostPortSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithHostPortSlice(key string, opts ...Option) ([]HostPort, error) {
+	return GetFrom[[]HostPort](l, key, opts...)
+}
+
+// WithIPSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of netip.Addr, the same way the package-level WithIPSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithIPSlice(key string, opts ...Option) ([]netip.Addr, error) {
+	return GetFrom[[]netip.Addr](l, key, opts...)
+}
+
+// WithIPNetSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of netip.Prefix, the same way the package-level WithIPNetSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithIPNetSlice(key string, opts ...Option) ([]netip.Prefix, error) {
+	return GetFrom[[]netip.Prefix](l, key, opts...)
+}
+
+// WithDefaultURL retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default URL provided.
+//
+// This method is a convenience wrapper around WithURL to silent the error and return a fallback value.
+func (l *Loader) WithDefaultURL(key string, fallback *url.URL, opts ...Option) *url.URL {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultHostPort retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default HostPort provided.
+//
+// This method is a convenience wrapper around WithHostPort to silent the error and return a fallback value.
+func (l *Loader) WithDefaultHostPort(key string, fallback HostPort, opts ...Option) HostPort {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultIP retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default netip.Addr provided.
+//
+// This method is a convenience wrapper around WithIP to silent the error and return a fallback value.
+func (l *Loader) WithDefaultIP(key string, fallback netip.Addr, opts ...Option) netip.Addr {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultIPNet retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default netip.Prefix provided.
+//
+// This method is a convenience wrapper around WithIPNet to silent the error and return a fallback value.
+func (l *Loader) WithDefaultIPNet(key string, fallback netip.Prefix, opts ...Option) netip.Prefix {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultURLSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []URL provided.
+//
+// This method is a convenience wrapper around WithURLSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultURLSlice(key string, fallback []*url.URL, opts ...Option) []*url.URL {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultHostPortSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []HostPort provided.
+//
+// This method is a convenience wrapper around WithHostPortSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultHostPortSlice(key string, fallback []HostPort, opts ...Option) []HostPort {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultIPSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []netip.Addr provided.
+//
+// This method is a convenience wrapper around WithIPSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultIPSlice(key string, fallback []netip.Addr, opts ...Option) []netip.Addr {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultIPNetSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []netip.Prefix provided.
+//
+// This method is a convenience wrapper around WithIPNetSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultIPNetSlice(key string, fallback []netip.Prefix, opts ...Option) []netip.Prefix {
+	return GetOrFrom(l, key, fallback, opts...)
+}
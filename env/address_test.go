@@ -0,0 +1,186 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"net/netip"
+	"testing"
+)
+
+func TestWithURL(t *testing.T) {
+	cases := []testutils.TestCase[string]{
+		{
+			Name:     "correctly parses an absolute URL",
+			Env:      map[string]string{"ENDPOINT": "https://api.example.com:8443/v1?debug=true"},
+			Expected: "https://api.example.com:8443/v1?debug=true",
+		},
+		{
+			Name:     "accepts an allowed scheme regardless of its case",
+			Env:      map[string]string{"ENDPOINT": "HTTP://localhost"},
+			Given:    "http",
+			Expected: "http://localhost",
+		},
+		{
+			Name:       "returns an error if the scheme is not allowed",
+			Env:        map[string]string{"ENDPOINT": "ftp://files.example.com"},
+			Given:      "https",
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error if the URL is relative",
+			Env:        map[string]string{"ENDPOINT": "/api/v1"},
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error if a host:port is given instead of a URL",
+			Env:        map[string]string{"ENDPOINT": "localhost:8080"},
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error if the URL cannot be parsed",
+			Env:        map[string]string{"ENDPOINT": "http://[::1"},
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			var opts []Option
+			if tc.Given != "" {
+				opts = append(opts, Schemes(tc.Given))
+			}
+			received, err := WithURL("ENDPOINT", opts...)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received.String())
+			}
+		})
+	}
+}
+
+func TestWithHostPort(t *testing.T) {
+	cases := []testutils.TestCase[HostPort]{
+		{
+			Name:     "correctly parses a host and a port",
+			Given:    "db.internal:5432",
+			Expected: HostPort{Host: "db.internal", Port: 5432},
+		},
+		{
+			Name:     "correctly parses an IPv6 host",
+			Given:    "[::1]:8080",
+			Expected: HostPort{Host: "::1", Port: 8080},
+		},
+		{
+			Name:     "accepts an empty host",
+			Given:    ":8080",
+			Expected: HostPort{Port: 8080},
+		},
+		{
+			Name:       "returns an error if the port is missing",
+			Given:      "db.internal",
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error if the port is not a number",
+			Given:      "db.internal:postgres",
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error if the port is out of range",
+			Given:      "db.internal:65536",
+			ShouldFail: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			received, err := NewLoader(Map{"ADDR": tc.Given}).WithHostPort("ADDR")
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestHostPort_String(t *testing.T) {
+	assert.Equal(t, "db.internal:5432", HostPort{Host: "db.internal", Port: 5432}.String())
+	assert.Equal(t, "[::1]:8080", HostPort{Host: "::1", Port: 8080}.String())
+}
+
+func TestWithIP(t *testing.T) {
+	l := NewLoader(Map{
+		"IPV4":    "10.0.0.1",
+		"IPV6":    "fe80::1",
+		"INVALID": "10.0.0.256",
+		"CIDR":    "10.0.0.0/8",
+	})
+
+	ip, err := l.WithIP("IPV4")
+	assert.NoError(t, err)
+	assert.Equal(t, netip.MustParseAddr("10.0.0.1"), ip)
+
+	ip, err = l.WithIP("IPV6")
+	assert.NoError(t, err)
+	assert.True(t, ip.Is6())
+
+	_, err = l.WithIP("INVALID")
+	assert.ErrorContains(t, err, "INVALID: could not parse IP address")
+
+	_, err = l.WithIP("CIDR")
+	assert.Error(t, err)
+
+	assert.Equal(t, netip.IPv6Loopback(), l.WithDefaultIP("UNSET", netip.IPv6Loopback()))
+}
+
+func TestWithIPNet(t *testing.T) {
+	l := NewLoader(Map{
+		"NETWORK": "10.0.0.0/8",
+		"INVALID": "10.0.0.0/33",
+	})
+
+	network, err := l.WithIPNet("NETWORK")
+	assert.NoError(t, err)
+	assert.True(t, network.Contains(netip.MustParseAddr("10.1.2.3")))
+
+	_, err = l.WithIPNet("INVALID")
+	assert.ErrorContains(t, err, "INVALID: could not parse IP network")
+}
+
+func TestAddressSlices(t *testing.T) {
+	l := NewLoader(Map{
+		"BROKERS":   "kafka-1:9092, kafka-2:9092",
+		"NETWORKS":  "10.0.0.0/8,192.168.0.0/16",
+		"ENDPOINTS": "https://a.example.com,ftp://b.example.com",
+	})
+
+	brokers, err := l.WithHostPortSlice("BROKERS")
+	assert.NoError(t, err)
+	assert.Equal(t, []HostPort{{Host: "kafka-1", Port: 9092}, {Host: "kafka-2", Port: 9092}}, brokers)
+
+	networks, err := l.WithIPNetSlice("NETWORKS")
+	assert.NoError(t, err)
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("192.168.0.0/16")}, networks)
+
+	_, err = l.WithURLSlice("ENDPOINTS", Schemes("https"))
+	assert.ErrorContains(t, err, "could not parse element 1")
+
+	fallback := []netip.Addr{netip.MustParseAddr("127.0.0.1")}
+	assert.Equal(t, fallback, l.WithDefaultIPSlice("UNSET", fallback))
+}
@@ -0,0 +1,93 @@
+package env
+
+// WithBase64 retrieves the value of an environment variable identified by the key
+// and decodes it as base64. The standard encoding is expected, unless another one is set with
+// the Base64Encoding option, e.g. base64.URLEncoding or base64.RawStdEncoding.
+// The Sensitive option can be given to keep binary secrets out of the errors, and validators
+// such as NonZero or Check apply to the decoded bytes as a whole.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithBase64(key string, opts ...Option) ([]byte, error) {
+	return Get[[]byte](key, withParser(opts, base64Parser)...)
+}
+
+// WithHex retrieves the value of an environment variable identified by the key
+// and decodes it as hexadecimal. The Sensitive option can be given to keep binary secrets out
+// of the errors, and validators such as NonZero or Check apply to the decoded bytes as a whole.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithHex(key string, opts ...Option) ([]byte, error) {
+	return Get[[]byte](key, withParser(opts, hexParser)...)
+}
+
+// WithDefaultBase64 retrieves the value of an environment variable identified by the key as base64 encoded bytes.
+// If the environment variable is not set, empty or cannot be decoded, it returns the fallback default []byte provided.
+//
+// This method is a convenience wrapper around WithBase64 to silent the error and return a fallback value.
+func WithDefaultBase64(key string, fallback []byte, opts ...Option) []byte {
+	return GetOr(key, fallback, withParser(opts, base64Parser)...)
+}
+
+// WithDefaultHex retrieves the value of an environment variable identified by the key as hex encoded bytes.
+// If the environment variable is not set, empty or cannot be decoded, it returns the fallback default []byte provided.
+//
+// This method is a convenience wrapper around WithHex to silent the error and return a fallback value.
+func WithDefaultHex(key string, fallback []byte, opts ...Option) []byte {
+	return GetOr(key, fallback, withParser(opts, hexParser)...)
+}
+
+// MustBase64 is like WithBase64 but panics if the environment variable cannot be retrieved or decoded.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustBase64(key string, opts ...Option) []byte {
+	return Must(WithBase64(key, opts...))
+}
+
+// MustHex is like WithHex but panics if the environment variable cannot be retrieved or decoded.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustHex(key string, opts ...Option) []byte {
+	return Must(WithHex(key, opts...))
+}
+
+// WithBase64 retrieves the value of the variable identified by the key from the loader's source
+// and decodes it as base64, the same way the package-level WithBase64 does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithBase64(key string, opts ...Option) ([]byte, error) {
+	return GetFrom[[]byte](l, key, withParser(opts, base64Parser)...)
+}
+
+// WithHex retrieves the value of the variable identified by the key from the loader's source
+// and decodes it as hexadecimal, the same way the package-level WithHex does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithHex(key string, opts ...Option) ([]byte, error) {
+	return GetFrom[[]byte](l, key, withParser(opts, hexParser)...)
+}
+
+// WithDefaultBase64 retrieves the value of the variable identified by the key from the loader's source as base64 encoded bytes.
+// If the variable is not set, empty or cannot be decoded, it returns the fallback default []byte provided.
+//
+// This method is a convenience wrapper around WithBase64 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultBase64(key string, fallback []byte, opts ...Option) []byte {
+	return GetOrFrom(l, key, fallback, withParser(opts, base64Parser)...)
+}
+
+// WithDefaultHex retrieves the value of the variable identified by the key from the loader's source as hex encoded bytes.
+// If the variable is not set, empty or cannot be decoded, it returns the fallback default []byte provided.
+//
+// This method is a convenience wrapper around WithHex to silent the error and return a fallback value.
+func (l *Loader) WithDefaultHex(key string, fallback []byte, opts ...Option) []byte {
+	return GetOrFrom(l, key, fallback, withParser(opts, hexParser)...)
+}
+
+func base64Parser(v string, o *options) (any, error) {
+	return parseBase64(v, o.base64)
+}
+
+func hexParser(v string, _ *options) (any, error) {
+	return parseHex(v)
+}
@@ -0,0 +1,117 @@
+package env
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"testing"
+)
+
+func TestWithBase64(t *testing.T) {
+	cases := []testutils.TestCase[[]byte]{
+		{
+			Name:     "decodes standard base64",
+			Given:    "/+8=",
+			Expected: []byte{0xff, 0xef},
+		},
+		{
+			Name:       "returns an error on URL-safe base64 by default",
+			Given:      "_-8=",
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error on unpadded base64 by default",
+			Given:      "/+8",
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error on invalid base64",
+			Given:      "not base64!",
+			ShouldFail: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			received, err := NewLoader(Map{"KEY": tc.Given}).WithBase64("KEY")
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithBase64_Encoding(t *testing.T) {
+	l := NewLoader(Map{
+		"URL":     "_-8=",
+		"RAW":     "/+8",
+		"RAW_URL": "_-8",
+	})
+
+	for key, enc := range map[string]*base64.Encoding{
+		"URL":     base64.URLEncoding,
+		"RAW":     base64.RawStdEncoding,
+		"RAW_URL": base64.RawURLEncoding,
+	} {
+		received, err := l.WithBase64(key, Base64Encoding(enc))
+		assert.NoError(t, err, key)
+		assert.Equal(t, []byte{0xff, 0xef}, received, key)
+	}
+
+	raw := NewLoader(Map{"KEY": "/+8"}, Base64Encoding(base64.RawStdEncoding))
+	assert.Equal(t, []byte{0xff, 0xef}, raw.WithDefaultBase64("KEY", nil))
+
+	std, err := NewLoader(Map{"KEY": "/+8="}).WithBase64("KEY", Base64Encoding(nil))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xff, 0xef}, std)
+
+	_, err = raw.WithBase64("KEY", Base64Encoding(nil))
+	assert.NoError(t, err)
+}
+
+func TestWithHex(t *testing.T) {
+	t.Setenv("HMAC_KEY", "deadBEEF")
+	t.Setenv("INVALID", "xyz")
+
+	key, err := WithHex("HMAC_KEY")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, key)
+
+	_, err = WithHex("INVALID", Sensitive())
+	assert.EqualError(t, err, "INVALID: invalid []uint8 value (redacted)")
+
+	_, err = WithHex("INVALID")
+	assert.ErrorContains(t, err, "INVALID: could not parse hex")
+
+	assert.Equal(t, []byte{0x01}, WithDefaultHex("UNSET", []byte{0x01}))
+	assert.Equal(t, []byte{0x01}, WithDefaultHex("INVALID", []byte{0x01}))
+}
+
+func TestBinary_Validators(t *testing.T) {
+	l := NewLoader(Map{
+		"KEY":   "AAEC",
+		"SHORT": "0001",
+	})
+
+	key, err := l.WithBase64("KEY", NonZero())
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{0x00, 0x01, 0x02}, key)
+
+	minLength := Check(func(b []byte) error {
+		if len(b) < 3 {
+			return errors.New("must be at least 3 bytes long")
+		}
+		return nil
+	})
+
+	_, err = l.WithBase64("KEY", minLength)
+	assert.NoError(t, err)
+
+	_, err = l.WithHex("SHORT", minLength)
+	assert.EqualError(t, err, "SHORT: invalid value: must be at least 3 bytes long")
+}
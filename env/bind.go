@@ -0,0 +1,207 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+const (
+	tagName       = "env"
+	prefixTagName = "envPrefix"
+)
+
+var ErrInvalidTarget = errors.New("target must be a non-nil pointer to a struct")
+
+// Bind populates the struct pointed to by dst from the environment variables
+// referenced by its `env` struct tags.
+//
+//	type Config struct {
+//		Host  string  `env:"HOST"`
+//		Port  int     `env:"PORT"`
+//		Debug bool    `env:"DEBUG"`
+//		DB    struct {
+//			Timeout float64 `env:"TIMEOUT"`
+//		} `envPrefix:"DB_"`
+//	}
+//
+// Untagged struct fields are walked recursively, other untagged fields and
+// fields tagged with `env:"-"` are ignored. The keys of a nested struct can be
+// prefixed with an `envPrefix` tag on the struct field, the same way WithPrefix
+// does. Values are parsed with the parsers used by Get, including the ones
+// registered with RegisterParser, and fields of other types implementing
+// encoding.TextUnmarshaler, flag.Value or json.Unmarshaler are decoded with it.
+//
+// Options can follow the key in the tag, separated by commas:
+//
+//   - allowempty accepts an empty value, as the AllowEmpty option does.
+//   - expand expands the references to other variables, as the Expand option does.
+//   - file reads the value from the file referenced by KEY_FILE, as the FileFallback option does.
+//   - secret redacts the raw value from the errors, as the Sensitive option does. It is
+//     implied for Secret fields.
+//   - min=N, max=N and oneof=A B C validate the value, as the Min, Max and OneOf options
+//     do. Their values are parsed as the field type, or its element type for slices and maps.
+//   - nonzero rejects zero values, as the NonZero option does.
+//   - match=REGEXP validates the value against the regular expression, as the Matches
+//     option does. It must be the last option, since the expression may contain commas.
+//
+// Every field is processed even if some of them fail, and the returned Errors
+// lists the error of each failing field wrapped with the path of the field, so
+// ErrUndefinedVariable and ErrEmptyVariable can still be checked with errors.Is.
+//
+// ErrInvalidTarget is returned if dst is not a non-nil pointer to a struct.
+func Bind(dst any) error {
+	return std.Bind(dst)
+}
+
+// Bind populates the struct pointed to by dst from the variables of the
+// loader's source, the same way the package-level Bind does.
+func (l *Loader) Bind(dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrInvalidTarget
+	}
+
+	var errs Errors
+	l.bindStruct(rv.Elem(), "", &errs)
+	return errs.err()
+}
+
+// bindStruct populates every exported field of the given struct value, and
+// records the errors of the fields that could not be populated.
+func (l *Loader) bindStruct(rv reflect.Value, path string, errs *Errors) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fieldPath := field.Name
+		if path != "" {
+			fieldPath = path + "." + field.Name
+		}
+
+		tag, tagged := field.Tag.Lookup(tagName)
+		key, rawOpts, _ := strings.Cut(tag, ",")
+		if key == "-" {
+			continue
+		}
+
+		if !tagged || key == "" {
+			if field.Type.Kind() == reflect.Struct {
+				nested := l
+				if prefix, ok := field.Tag.Lookup(prefixTagName); ok {
+					nested = l.WithPrefix(prefix)
+				}
+				nested.bindStruct(rv.Field(i), fieldPath, errs)
+			}
+			continue
+		}
+
+		opts, err := tagOptions(rawOpts, field.Type)
+		if err == nil {
+			err = l.bindField(rv.Field(i), key, opts)
+		}
+		if err != nil {
+			*errs = append(*errs, fmt.Errorf("could not bind %s: %w", fieldPath, err))
+		}
+	}
+}
+
+// bindField retrieves the variable identified by the key and stores its value,
+// parsed with the parser registered for the field type, into the field.
+func (l *Loader) bindField(field reflect.Value, key string, opts []Option) error {
+	o := l.options(opts)
+
+	parsed, err := l.get(key, field.Type(), &o)
+	if err != nil {
+		return err
+	}
+
+	field.Set(reflect.ValueOf(parsed))
+	return nil
+}
+
+// tagOptions returns the options declared after the key in an `env` struct tag
+// of a field of type t.
+func tagOptions(raw string, t reflect.Type) ([]Option, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var opts []Option
+	for raw != "" {
+		var opt string
+		if strings.HasPrefix(strings.TrimSpace(raw), "match=") {
+			opt, raw = strings.TrimSpace(raw), ""
+		} else {
+			opt, raw, _ = strings.Cut(raw, ",")
+		}
+
+		name, arg, _ := strings.Cut(strings.TrimSpace(opt), "=")
+		switch name {
+		case "allowempty":
+			opts = append(opts, AllowEmpty())
+		case "expand":
+			opts = append(opts, Expand())
+		case "file":
+			opts = append(opts, FileFallback())
+		case "secret":
+			opts = append(opts, Sensitive())
+		case "nonzero":
+			opts = append(opts, NonZero())
+		case "min", "max", "oneof":
+			v, err := tagValidator(name, arg, t)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, func(o *options) { o.validate(v) })
+		case "match":
+			re, err := regexp.Compile(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid tag option %q: %w", opt, err)
+			}
+			opts = append(opts, Matches(re))
+		default:
+			return nil, fmt.Errorf("unknown tag option %q", opt)
+		}
+	}
+	return opts, nil
+}
+
+// tagValidator returns the validator of a min, max or oneof tag option, whose
+// values are parsed as the element type of t.
+func tagValidator(name, arg string, t reflect.Type) (validator, error) {
+	for t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+
+	p, err := parserFor(t)
+	if err != nil {
+		return nil, err
+	}
+
+	o := defaultOptions()
+	var values []any
+	for _, raw := range strings.Fields(arg) {
+		v, err := p(raw, &o)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag option %s=%s: %w", name, arg, err)
+		}
+		values = append(values, v)
+	}
+
+	switch {
+	case name == "oneof" && len(values) > 0:
+		return oneOfValidator(values), nil
+	case len(values) != 1:
+		return nil, fmt.Errorf("invalid tag option %s=%s: expected a single value", name, arg)
+	case name == "min":
+		return minValidator(values[0]), nil
+	default:
+		return maxValidator(values[0]), nil
+	}
+}
@@ -0,0 +1,165 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"testing"
+)
+
+type bindTestConfig struct {
+	Host    string  `env:"HOST"`
+	Port    int     `env:"PORT"`
+	Debug   bool    `env:"DEBUG"`
+	Ratio   float32 `env:"RATIO"`
+	Ignored string  `env:"-"`
+	Nested  struct {
+		Retries int8 `env:"NESTED_RETRIES"`
+	}
+	unexported string `env:"UNEXPORTED"`
+}
+
+func TestBind(t *testing.T) {
+	expected := bindTestConfig{Host: "localhost", Port: 8080, Debug: true, Ratio: 0.5}
+	expected.Nested.Retries = 3
+
+	cases := []testutils.TestCase[bindTestConfig]{
+		{
+			Name:     "correctly populates the struct",
+			Expected: expected,
+			Env: map[string]string{
+				"HOST":           "localhost",
+				"PORT":           "8080",
+				"DEBUG":          "true",
+				"RATIO":          "0.5",
+				"NESTED_RETRIES": "3",
+				"UNEXPORTED":     "ignored",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if a nested env var is not defined",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+			Env: map[string]string{
+				"HOST":  "localhost",
+				"PORT":  "8080",
+				"DEBUG": "true",
+				"RATIO": "0.5",
+			},
+		},
+		{
+			Name:       "returns ErrEmptyVariable if an env var is empty",
+			ShouldFail: true,
+			Error:      ErrEmptyVariable,
+			Env: map[string]string{
+				"HOST": "",
+			},
+		},
+		{
+			Name:       "returns an error if a value cannot be parsed",
+			ShouldFail: true,
+			Env: map[string]string{
+				"HOST": "localhost",
+				"PORT": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			var received bindTestConfig
+			err := Bind(&received)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestBind_ErrorContainsFieldPath(t *testing.T) {
+	t.Setenv("HOST", "localhost")
+	t.Setenv("PORT", "8080")
+	t.Setenv("DEBUG", "true")
+	t.Setenv("RATIO", "0.5")
+	t.Setenv("NESTED_RETRIES", "1000")
+
+	err := Bind(&bindTestConfig{})
+
+	assert.ErrorContains(t, err, "could not bind Nested.Retries")
+}
+
+func TestBind_InvalidTarget(t *testing.T) {
+	var cfg bindTestConfig
+
+	assert.ErrorIs(t, Bind(cfg), ErrInvalidTarget)
+	assert.ErrorIs(t, Bind((*bindTestConfig)(nil)), ErrInvalidTarget)
+	assert.ErrorIs(t, Bind(new(int)), ErrInvalidTarget)
+}
+
+func TestBind_UnsupportedType(t *testing.T) {
+	t.Setenv("VALUE", "1+2i")
+
+	var cfg struct {
+		Value complex128 `env:"VALUE"`
+	}
+
+	assert.ErrorIs(t, Bind(&cfg), ErrUnsupportedType)
+}
+
+func TestBind_TagOptions(t *testing.T) {
+	t.Setenv("PREFIX", "")
+	t.Setenv("PROXY", "")
+
+	var cfg struct {
+		Prefix string `env:"PREFIX,allowempty"`
+		Proxy  string `env:"PROXY"`
+	}
+
+	err := Bind(&cfg)
+
+	var errs Errors
+	assert.ErrorAs(t, err, &errs)
+	assert.Len(t, errs, 1)
+	assert.ErrorIs(t, err, ErrEmptyVariable)
+	assert.ErrorContains(t, err, "could not bind Proxy")
+}
+
+func TestBind_UnknownTagOption(t *testing.T) {
+	t.Setenv("FOO", "bar")
+
+	var cfg struct {
+		Foo string `env:"FOO,unknown"`
+	}
+
+	assert.ErrorContains(t, Bind(&cfg), `unknown tag option "unknown"`)
+}
+
+func TestBind_Prefix(t *testing.T) {
+	l := NewLoader(Map{
+		"BILLING_NAME":    "billing",
+		"BILLING_DB_HOST": "billing.db",
+	}).WithPrefix("BILLING_")
+
+	var cfg struct {
+		Name string `env:"NAME"`
+		DB   struct {
+			Host string `env:"HOST"`
+			Port int    `env:"PORT"`
+		} `envPrefix:"DB_"`
+	}
+
+	err := l.Bind(&cfg)
+
+	assert.Equal(t, "billing", cfg.Name)
+	assert.Equal(t, "billing.db", cfg.DB.Host)
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+	assert.EqualError(t, err, "could not bind DB.Port: BILLING_DB_PORT: "+ErrUndefinedVariable.Error())
+}
@@ -0,0 +1,75 @@
+package env
+
+import "fmt"
+
+// ChainSource is a Source resolving variables through several layers of sources.
+// It is created with Chain.
+type ChainSource struct {
+	layers []Source
+}
+
+// Chain returns a Source resolving each variable through the given sources in
+// order: the first source defining the variable supplies its value, even if the
+// value is empty. Sources should therefore be given by decreasing precedence,
+// for instance:
+//
+//	src := env.Chain(
+//		env.Named("overrides", env.Map(overrides)),
+//		env.Named("env", env.OS()),
+//		env.Named("dotenv", dotenv),
+//		env.Named("defaults", env.Map{"PORT": "8080"}),
+//	)
+//
+// Using a map of defaults as the last layer allows the With* functions to apply
+// defaults, while still reporting parsing errors instead of swallowing them like
+// the WithDefault* functions do.
+func Chain(sources ...Source) *ChainSource {
+	return &ChainSource{layers: sources}
+}
+
+// Lookup retrieves the value of the variable identified by the key from the first
+// layer defining it.
+func (c *ChainSource) Lookup(key string) (string, bool) {
+	val, _, ok := c.resolve(key)
+	return val, ok
+}
+
+// Origin returns the name of the layer supplying the variable identified by the key.
+// Layers created with Named are reported by their name, other layers by their
+// position in the chain, e.g. "#1" for the first one.
+//
+// The boolean is false if no layer defines the variable.
+func (c *ChainSource) Origin(key string) (string, bool) {
+	_, layer, ok := c.resolve(key)
+	if !ok {
+		return "", false
+	}
+
+	if named, ok := c.layers[layer].(*namedSource); ok {
+		return named.name, true
+	}
+	return fmt.Sprintf("#%d", layer+1), true
+}
+
+// resolve returns the value of the variable identified by the key, and the index
+// of the layer supplying it.
+func (c *ChainSource) resolve(key string) (string, int, bool) {
+	for i, layer := range c.layers {
+		if val, ok := layer.Lookup(key); ok {
+			return val, i, true
+		}
+	}
+	return "", -1, false
+}
+
+// Named returns a Source reading variables from the given source, identified by
+// the name when reported by ChainSource.Origin.
+func Named(name string, source Source) Source {
+	return &namedSource{name: name, Source: source}
+}
+
+// namedSource is a Source identified by a name.
+type namedSource struct {
+	Source
+	name string
+}
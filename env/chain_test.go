@@ -0,0 +1,54 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	c := Chain(
+		Named("overrides", Map{"PORT": "9090"}),
+		Named("env", Map{"PORT": "8080", "HOST": "example.com", "EMPTY": ""}),
+		Map{"HOST": "localhost", "EMPTY": "fallback", "DEBUG": "true"},
+	)
+
+	cases := []struct {
+		Name   string
+		Key    string
+		Value  string
+		Origin string
+		Found  bool
+	}{
+		{Name: "the first layer takes precedence", Key: "PORT", Value: "9090", Origin: "overrides", Found: true},
+		{Name: "resolves through the next layers", Key: "HOST", Value: "example.com", Origin: "env", Found: true},
+		{Name: "empty values shadow the next layers", Key: "EMPTY", Value: "", Origin: "env", Found: true},
+		{Name: "reports unnamed layers by position", Key: "DEBUG", Value: "true", Origin: "#3", Found: true},
+		{Name: "reports undefined variables", Key: "UNSET", Found: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			val, ok := c.Lookup(tc.Key)
+			assert.Equal(t, tc.Found, ok)
+			assert.Equal(t, tc.Value, val)
+
+			origin, ok := c.Origin(tc.Key)
+			assert.Equal(t, tc.Found, ok)
+			assert.Equal(t, tc.Origin, origin)
+		})
+	}
+}
+
+func TestChain_DefaultsLayer(t *testing.T) {
+	l := NewLoader(Chain(
+		Map{"PORT": "80a0"},
+		Named("defaults", Map{"PORT": "8080", "HOST": "localhost"}),
+	))
+
+	host, err := l.WithString("HOST")
+	assert.NoError(t, err)
+	assert.Equal(t, "localhost", host)
+
+	_, err = l.WithInt("PORT")
+	assert.Error(t, err)
+}
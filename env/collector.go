@@ -0,0 +1,95 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Errors is an error listing every problem encountered while retrieving several
+// variables. It is returned by Collector.Err and Bind.
+//
+// errors.Is and errors.As match any of the listed errors, so a misconfiguration
+// can still be checked against ErrUndefinedVariable or ErrEmptyVariable.
+type Errors []error
+
+func (e Errors) Error() string {
+	if len(e) == 1 {
+		return e[0].Error()
+	}
+
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %s", len(e), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the listed errors.
+func (e Errors) Unwrap() []error {
+	return e
+}
+
+// err returns the list as an error, or nil if it is empty.
+func (e Errors) err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Collector retrieves many variables and records every error instead of failing
+// on the first one, so that a misconfiguration is reported at once:
+//
+//	c := env.NewCollector()
+//	host := env.Collect[string](c, "HOST")
+//	port := env.Collect[int](c, "PORT")
+//	if err := c.Err(); err != nil {
+//		// err lists both HOST and PORT if they are invalid.
+//	}
+type Collector struct {
+	loader *Loader
+	errs   Errors
+}
+
+// NewCollector returns a Collector retrieving environment variables from the process environment.
+func NewCollector() *Collector {
+	return std.NewCollector()
+}
+
+// NewCollector returns a Collector retrieving variables from the loader's source.
+func (l *Loader) NewCollector() *Collector {
+	return &Collector{loader: l}
+}
+
+// Collect retrieves the value of the variable identified by the key and parses
+// it into T, the same way GetFrom does. If it fails, the error is recorded in the
+// collector and the zero value of T is returned.
+func Collect[T any](c *Collector, key string, opts ...Option) T {
+	val, err := GetFrom[T](c.loader, key, opts...)
+	c.Add(key, err)
+	return val
+}
+
+// Add records the error returned by the retrieval of the variable identified by
+// the key. Errors that are not a *VarError are wrapped into one. It is a no-op if
+// err is nil, which allows recording the result of any getter:
+//
+//	timeout, err := env.WithDuration("TIMEOUT")
+//	c.Add("TIMEOUT", err)
+func (c *Collector) Add(key string, err error) {
+	if err == nil {
+		return
+	}
+
+	var varErr *VarError
+	if !errors.As(err, &varErr) {
+		err = &VarError{Key: c.loader.key(key), Err: err}
+	}
+	c.errs = append(c.errs, err)
+}
+
+// Err returns an Errors listing every recorded error, or nil if no error has been recorded.
+func (c *Collector) Err() error {
+	return c.errs.err()
+}
@@ -0,0 +1,67 @@
+package env
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestCollector(t *testing.T) {
+	l := NewLoader(Map{
+		"HOST":    "localhost",
+		"PORT":    "80a0",
+		"EMPTY":   "",
+		"TIMEOUT": "30s",
+	})
+	c := l.NewCollector()
+
+	host := Collect[string](c, "HOST")
+	port := Collect[int](c, "PORT")
+	empty := Collect[string](c, "EMPTY")
+	unset := Collect[bool](c, "UNSET")
+	timeout, err := l.WithDuration("TIMEOUT")
+	c.Add("TIMEOUT", err)
+
+	assert.Equal(t, "localhost", host)
+	assert.Zero(t, port)
+	assert.Zero(t, empty)
+	assert.False(t, unset)
+	assert.NotZero(t, timeout)
+
+	err = c.Err()
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+	assert.ErrorIs(t, err, ErrEmptyVariable)
+
+	var errs Errors
+	assert.True(t, errors.As(err, &errs))
+	assert.Len(t, errs, 3)
+	assert.ErrorContains(t, err, "3 errors occurred")
+	assert.ErrorContains(t, err, "PORT: could not parse int")
+	assert.ErrorContains(t, err, "EMPTY: "+ErrEmptyVariable.Error())
+	assert.ErrorContains(t, err, "UNSET: "+ErrUndefinedVariable.Error())
+}
+
+func TestCollector_NoError(t *testing.T) {
+	t.Setenv("FOO", "bar")
+
+	c := NewCollector()
+
+	assert.Equal(t, "bar", Collect[string](c, "FOO"))
+	assert.NoError(t, c.Err())
+}
+
+func TestBind_ReportsEveryField(t *testing.T) {
+	var cfg struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT"`
+	}
+
+	err := NewLoader(Map{"PORT": "80a0"}).Bind(&cfg)
+
+	var errs Errors
+	assert.True(t, errors.As(err, &errs))
+	assert.Len(t, errs, 2)
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+	assert.ErrorContains(t, err, "could not bind Host")
+	assert.ErrorContains(t, err, "could not bind Port")
+}
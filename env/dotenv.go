@@ -0,0 +1,254 @@
+package env
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// SyntaxError describes a malformed entry encountered while parsing a dotenv file.
+type SyntaxError struct {
+	// Line is the line, starting at 1, where the malformed entry begins.
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("dotenv: syntax error at line %d: %s", e.Line, e.Msg)
+}
+
+// LoadFile reads and parses the dotenv file at the given path. The returned Map
+// can be used as the Source of a Loader.
+//
+// See ParseDotenv for the supported syntax.
+func LoadFile(path string) (Map, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not load dotenv file: %w", err)
+	}
+	defer f.Close()
+
+	vars, err := ParseDotenv(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not load dotenv file %s: %w", path, err)
+	}
+	return vars, nil
+}
+
+// ParseDotenv parses the variables declared in the dotenv content read from r.
+// The returned Map can be used as the Source of a Loader.
+//
+// Each entry is declared as KEY=value, optionally prefixed with `export`. Lines
+// starting with # are comments, and unquoted values end at the first # preceded
+// by a whitespace. Values can be quoted:
+//
+//   - 'single quotes' preserve the content as is.
+//   - "double quotes" interpret the \n, \r, \t, \" and \\ escape sequences. The \$ sequence
+//     is kept as is, so that it is read as a literal $ when the Expand option is given.
+//   - `backticks` preserve the content as is, and may contain both ' and ".
+//
+// Quoted values may span multiple lines. A *SyntaxError carrying the line of the
+// malformed entry is returned if the content cannot be parsed.
+func ParseDotenv(r io.Reader) (Map, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read dotenv content: %w", err)
+	}
+
+	p := &dotenvParser{
+		src:  strings.ReplaceAll(string(content), "\r\n", "\n"),
+		line: 1,
+	}
+
+	vars := Map{}
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return vars, nil
+		}
+
+		key, val, err := p.parseEntry()
+		if err != nil {
+			return nil, err
+		}
+		vars[key] = val
+	}
+}
+
+// dotenvParser is a helper to scan dotenv content while keeping track of the current line.
+type dotenvParser struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (p *dotenvParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *dotenvParser) peek() byte {
+	return p.src[p.pos]
+}
+
+func (p *dotenvParser) errorf(line int, format string, args ...any) error {
+	return &SyntaxError{Line: line, Msg: fmt.Sprintf(format, args...)}
+}
+
+// skipBlank skips whitespaces, empty lines and comment lines.
+func (p *dotenvParser) skipBlank() {
+	for !p.eof() {
+		switch p.peek() {
+		case '\n':
+			p.line++
+			p.pos++
+		case ' ', '\t', '\r':
+			p.pos++
+		case '#':
+			p.skipLine()
+		default:
+			return
+		}
+	}
+}
+
+// skipSpaces skips the spaces and tabs without moving to the next line.
+func (p *dotenvParser) skipSpaces() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+// skipLine moves to the end of the current line, without consuming the line break.
+func (p *dotenvParser) skipLine() {
+	for !p.eof() && p.peek() != '\n' {
+		p.pos++
+	}
+}
+
+// parseEntry parses a single KEY=value entry, including its trailing comment.
+func (p *dotenvParser) parseEntry() (string, string, error) {
+	line := p.line
+
+	if strings.HasPrefix(p.src[p.pos:], "export") {
+		rest := p.src[p.pos+len("export"):]
+		if rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			p.pos += len("export")
+			p.skipSpaces()
+		}
+	}
+
+	start := p.pos
+	for !p.eof() && isKeyChar(p.peek(), p.pos == start) {
+		p.pos++
+	}
+	key := p.src[start:p.pos]
+	if key == "" {
+		return "", "", p.errorf(line, "invalid character %q, expected a variable name", p.peek())
+	}
+
+	p.skipSpaces()
+	if p.eof() || p.peek() != '=' {
+		return "", "", p.errorf(line, "expected '=' after variable name %q", key)
+	}
+	p.pos++
+	p.skipSpaces()
+
+	var val string
+	var err error
+	if !p.eof() && (p.peek() == '\'' || p.peek() == '"' || p.peek() == '`') {
+		val, err = p.parseQuoted()
+	} else {
+		val = p.parseUnquoted()
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	p.skipSpaces()
+	if !p.eof() && p.peek() == '#' {
+		p.skipLine()
+	}
+	if !p.eof() && p.peek() != '\n' {
+		return "", "", p.errorf(p.line, "unexpected character %q after the value of %q", p.peek(), key)
+	}
+
+	return key, val, nil
+}
+
+// parseUnquoted parses a value up to the end of the line or to an inline comment.
+func (p *dotenvParser) parseUnquoted() string {
+	start := p.pos
+	for !p.eof() && p.peek() != '\n' {
+		if p.peek() == '#' && p.pos > start && (p.src[p.pos-1] == ' ' || p.src[p.pos-1] == '\t') {
+			break
+		}
+		p.pos++
+	}
+	return strings.TrimRight(p.src[start:p.pos], " \t\r")
+}
+
+// parseQuoted parses a value enclosed in single quotes, double quotes or backticks.
+func (p *dotenvParser) parseQuoted() (string, error) {
+	line := p.line
+	quote := p.peek()
+	p.pos++
+
+	var sb strings.Builder
+	for {
+		if p.eof() {
+			return "", p.errorf(line, "unterminated quoted value")
+		}
+
+		c := p.peek()
+		p.pos++
+
+		switch {
+		case c == quote:
+			return sb.String(), nil
+		case c == '\n':
+			p.line++
+			sb.WriteByte(c)
+		case c == '\\' && quote == '"' && !p.eof():
+			if p.peek() == '\n' {
+				p.line++
+			}
+			sb.WriteString(unescape(p.peek()))
+			p.pos++
+		default:
+			sb.WriteByte(c)
+		}
+	}
+}
+
+// unescape returns the character represented by the escape sequence \c. Unknown
+// escape sequences are preserved as is.
+func unescape(c byte) string {
+	switch c {
+	case 'n':
+		return "\n"
+	case 'r':
+		return "\r"
+	case 't':
+		return "\t"
+	case '"', '\\':
+		return string(c)
+	case '$':
+		// Kept escaped, so that the Expand option reads it as a literal $.
+		return `\$`
+	default:
+		return "\\" + string(c)
+	}
+}
+
+// isKeyChar reports whether c can be used in a variable name.
+func isKeyChar(c byte, first bool) bool {
+	switch {
+	case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9' || c == '.' || c == '-':
+		return !first
+	default:
+		return false
+	}
+}
@@ -0,0 +1,138 @@
+package env
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseDotenv(t *testing.T) {
+	cases := []testutils.TestCase[Map]{
+		{
+			Name:     "parses simple entries",
+			Given:    "FOO=bar\nBAZ = qux\n",
+			Expected: Map{"FOO": "bar", "BAZ": "qux"},
+		},
+		{
+			Name:     "ignores comments and blank lines",
+			Given:    "# comment\n\n  # indented comment\nFOO=bar # inline comment\nURL=http://host/#anchor\n",
+			Expected: Map{"FOO": "bar", "URL": "http://host/#anchor"},
+		},
+		{
+			Name:     "supports the export prefix",
+			Given:    "export FOO=bar\nexported=true\n",
+			Expected: Map{"FOO": "bar", "exported": "true"},
+		},
+		{
+			Name:     "supports empty values",
+			Given:    "FOO=\nBAR=''\n",
+			Expected: Map{"FOO": "", "BAR": ""},
+		},
+		{
+			Name:     "preserves single quoted values",
+			Given:    `FOO='bar # not a comment \n $BAZ'`,
+			Expected: Map{"FOO": `bar # not a comment \n $BAZ`},
+		},
+		{
+			Name:     "interprets escape sequences in double quoted values",
+			Given:    `FOO="line1\nline2\t\"quoted\" \\ \$HOME \q"`,
+			Expected: Map{"FOO": "line1\nline2\t\"quoted\" \\ \\$HOME \\q"},
+		},
+		{
+			Name:     "preserves backtick quoted values",
+			Given:    "FOO=`it's \"quoted\"`",
+			Expected: Map{"FOO": `it's "quoted"`},
+		},
+		{
+			Name:     "supports multi-line values",
+			Given:    "KEY=\"-----BEGIN KEY-----\nabc\n-----END KEY-----\"\r\nNEXT=1\n",
+			Expected: Map{"KEY": "-----BEGIN KEY-----\nabc\n-----END KEY-----", "NEXT": "1"},
+		},
+		{
+			Name:       "returns a syntax error if the equal sign is missing",
+			Given:      "FOO=bar\nBAZ\n",
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns a syntax error if the key is invalid",
+			Given:      "1FOO=bar\n",
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns a syntax error if a quoted value is not terminated",
+			Given:      "FOO=\"bar\n",
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns a syntax error if characters follow a quoted value",
+			Given:      "FOO='bar'baz\n",
+			ShouldFail: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			received, err := ParseDotenv(strings.NewReader(tc.Given))
+
+			if tc.ShouldFail {
+				var syntaxErr *SyntaxError
+				assert.True(t, errors.As(err, &syntaxErr))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestParseDotenv_SyntaxErrorLine(t *testing.T) {
+	_, err := ParseDotenv(strings.NewReader("FOO=\"multi\nline\"\n\n# comment\nBAR='unterminated\n"))
+
+	var syntaxErr *SyntaxError
+	assert.True(t, errors.As(err, &syntaxErr))
+	assert.Equal(t, 5, syntaxErr.Line)
+	assert.ErrorContains(t, err, "line 5")
+}
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	assert.NoError(t, os.WriteFile(path, []byte("PORT=8080\nexport DEBUG=true\n"), 0o600))
+
+	vars, err := LoadFile(path)
+	assert.NoError(t, err)
+
+	l := NewLoader(vars)
+
+	port, err := l.WithInt("PORT")
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, port)
+
+	debug, err := l.WithBool("DEBUG")
+	assert.NoError(t, err)
+	assert.True(t, debug)
+}
+
+func TestLoadFile_NotFound(t *testing.T) {
+	_, err := LoadFile(filepath.Join(t.TempDir(), ".env"))
+
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestParseDotenv_EscapedDollarWithExpand(t *testing.T) {
+	vars, err := ParseDotenv(strings.NewReader(`PASSWORD="pa\$word"` + "\nURL=\"http://${HOST}/\\$path\"\nHOST=localhost\n"))
+	assert.NoError(t, err)
+
+	l := NewLoader(vars)
+
+	password, err := l.WithString("PASSWORD", Expand())
+	assert.NoError(t, err)
+	assert.Equal(t, "pa$word", password)
+
+	url, err := l.WithString("URL", Expand())
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost/$path", url)
+}
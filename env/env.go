@@ -2,45 +2,47 @@ package env
 
 import (
 	"errors"
-	"fmt"
-	"os"
-	"strconv"
+	"time"
 )
 
 var (
 	ErrUndefinedVariable = errors.New("environment variable is undefined")
 	ErrEmptyVariable     = errors.New("environment variable is empty")
+	ErrUnsupportedType   = errors.New("unsupported type")
+	ErrDuplicateKey      = errors.New("duplicate key")
 )
 
+// Get retrieves the value of an environment variable identified by the key
+// and parses it into T using the parser registered for T with RegisterParser.
+// Parsers are built in for strings, booleans, signed and unsigned integers, floats,
+// time.Duration and time.Time, as well as for any type whose underlying type is one of them
+// and for slices and maps of supported types. Types implementing encoding.TextUnmarshaler,
+// flag.Value or json.Unmarshaler are decoded with it.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+// ErrUnsupportedType is returned if no parser is registered for T.
+func Get[T any](key string, opts ...Option) (T, error) {
+	return GetFrom[T](std, key, opts...)
+}
+
 // WithString retrieves the value of an environment variable identified by the key.
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithString(key string) (string, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return "", err
-	}
-	return val, nil
+func WithString(key string, opts ...Option) (string, error) {
+	return Get[string](key, opts...)
 }
 
 // WithBool retrieves the value of an environment variable identified by the key
 // and tries to parse it as a boolean. An error is returned if the boolean parsing fails.
+// Common values such as yes, on or enabled are accepted case-insensitively, see the
+// BoolValues and StrictBool options to change the accepted values.
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithBool(key string) (bool, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return false, err
-	}
-
-	parsed, err := strconv.ParseBool(val)
-	if err != nil {
-		return false, fmt.Errorf("could not parse boolean: %w", err)
-	}
-
-	return parsed, nil
+func WithBool(key string, opts ...Option) (bool, error) {
+	return Get[bool](key, opts...)
 }
 
 // WithInt retrieves the value of an environment variable identified by the key
@@ -48,18 +50,8 @@ func WithBool(key string) (bool, error) {
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithInt(key string) (int, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return -1, err
-	}
-
-	parsed, err := parseInt(val, 0, 0)
-	if err != nil {
-		return -1, err
-	}
-
-	return int(parsed), nil
+func WithInt(key string, opts ...Option) (int, error) {
+	return orInvalid(Get[int](key, opts...))
 }
 
 // WithInt8 retrieves the value of an environment variable identified by the key
@@ -67,18 +59,8 @@ func WithInt(key string) (int, error) {
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithInt8(key string) (int8, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return -1, err
-	}
-
-	parsed, err := parseInt(val, 0, 8)
-	if err != nil {
-		return -1, err
-	}
-
-	return int8(parsed), nil
+func WithInt8(key string, opts ...Option) (int8, error) {
+	return orInvalid(Get[int8](key, opts...))
 }
 
 // WithInt16 retrieves the value of an environment variable identified by the key
@@ -86,18 +68,8 @@ func WithInt8(key string) (int8, error) {
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithInt16(key string) (int16, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return -1, err
-	}
-
-	parsed, err := parseInt(val, 0, 16)
-	if err != nil {
-		return -1, err
-	}
-
-	return int16(parsed), nil
+func WithInt16(key string, opts ...Option) (int16, error) {
+	return orInvalid(Get[int16](key, opts...))
 }
 
 // WithInt32 retrieves the value of an environment variable identified by the key
@@ -105,18 +77,8 @@ func WithInt16(key string) (int16, error) {
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithInt32(key string) (int32, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return -1, err
-	}
-
-	parsed, err := parseInt(val, 0, 32)
-	if err != nil {
-		return -1, err
-	}
-
-	return int32(parsed), nil
+func WithInt32(key string, opts ...Option) (int32, error) {
+	return orInvalid(Get[int32](key, opts...))
 }
 
 // WithInt64 retrieves the value of an environment variable identified by the key
@@ -124,37 +86,77 @@ func WithInt32(key string) (int32, error) {
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithInt64(key string) (int64, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return -1, err
-	}
+func WithInt64(key string, opts ...Option) (int64, error) {
+	return orInvalid(Get[int64](key, opts...))
+}
 
-	parsed, err := parseInt(val, 0, 64)
-	if err != nil {
-		return -1, err
-	}
+// WithUint retrieves the value of an environment variable identified by the key
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint(key string, opts ...Option) (uint, error) {
+	return Get[uint](key, opts...)
+}
 
-	return int64(parsed), nil
+// WithUint8 retrieves the value of an environment variable identified by the key
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint8.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint8(key string, opts ...Option) (uint8, error) {
+	return Get[uint8](key, opts...)
 }
 
-// WithFloat32 retrieves the value of an environment variable identified by the key
-// and tries to parse it as a float32. An error is returned if the float32 parsing fails.
+// WithUint16 retrieves the value of an environment variable identified by the key
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint16.
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithFloat32(key string) (float32, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return -1, err
-	}
+func WithUint16(key string, opts ...Option) (uint16, error) {
+	return Get[uint16](key, opts...)
+}
 
-	parsed, err := parseFloat(val, 32)
-	if err != nil {
-		return -1, err
-	}
+// WithUint32 retrieves the value of an environment variable identified by the key
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint32.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint32(key string, opts ...Option) (uint32, error) {
+	return Get[uint32](key, opts...)
+}
 
-	return float32(parsed), nil
+// WithUint64 retrieves the value of an environment variable identified by the key
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint64.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint64(key string, opts ...Option) (uint64, error) {
+	return Get[uint64](key, opts...)
+}
+
+// WithUintptr retrieves the value of an environment variable identified by the key
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uintptr.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUintptr(key string, opts ...Option) (uintptr, error) {
+	return Get[uintptr](key, opts...)
+}
+
+// WithFloat32 retrieves the value of an environment variable identified by the key
+// and tries to parse it as a float32. An error is returned if the float32 parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithFloat32(key string, opts ...Option) (float32, error) {
+	return orInvalid(Get[float32](key, opts...))
 }
 
 // WithFloat64 retrieves the value of an environment variable identified by the key
@@ -162,30 +164,34 @@ func WithFloat32(key string) (float32, error) {
 //
 // ErrUndefinedVariable is returned if the environment variable lookup fails.
 // ErrEmptyVariable is returned if the environment variable is empty.
-func WithFloat64(key string) (float64, error) {
-	val, err := lookup(key)
-	if err != nil {
-		return -1, err
-	}
-
-	parsed, err := parseFloat(val, 64)
-	if err != nil {
-		return -1, err
-	}
+func WithFloat64(key string, opts ...Option) (float64, error) {
+	return orInvalid(Get[float64](key, opts...))
+}
 
-	return parsed, nil
+// WithDuration retrieves the value of an environment variable identified by the key
+// and tries to parse it as a time.Duration. An error is returned if the duration parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithDuration(key string, opts ...Option) (time.Duration, error) {
+	return Get[time.Duration](key, opts...)
 }
 
-// lookup is a helper function to check the content of an environment variable.
-func lookup(key string) (string, error) {
-	val, ok := os.LookupEnv(key)
-	if !ok {
-		return "", ErrUndefinedVariable
-	}
+// WithTime retrieves the value of an environment variable identified by the key
+// and tries to parse it as a time.Time, using the layout set with the Layout option
+// or time.RFC3339. An error is returned if the time parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithTime(key string, opts ...Option) (time.Time, error) {
+	return Get[time.Time](key, opts...)
+}
 
-	if val == "" {
-		return "", ErrEmptyVariable
+// orInvalid returns the value, or -1 along with the error if there is one, as
+// the signed integer and float getters always did.
+func orInvalid[T int | int8 | int16 | int32 | int64 | float32 | float64](v T, err error) (T, error) {
+	if err != nil {
+		return -1, err
 	}
-
-	return val, nil
+	return v, nil
 }
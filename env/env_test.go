@@ -3,9 +3,75 @@ package env
 import (
 	"github.com/stretchr/testify/assert"
 	"github.com/thomasgouveia/goutils/env/testutils"
+	"strconv"
 	"testing"
+	"time"
 )
 
+func TestGet(t *testing.T) {
+	type port int
+
+	cases := []testutils.TestCase[port]{
+		{
+			Name:     "correctly retrieves the value of a named type",
+			Given:    "FOO",
+			Expected: 8080,
+			Env: map[string]string{
+				"FOO": "8080",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns ErrEmptyVariable if the env var is empty",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrEmptyVariable,
+			Env: map[string]string{
+				"FOO": "",
+			},
+		},
+		{
+			Name:       "returns an error if the value is not an integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := Get[port](tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestGet_UnsupportedType(t *testing.T) {
+	t.Setenv("FOO", "bar")
+
+	_, err := Get[struct{}]("FOO")
+
+	assert.ErrorIs(t, err, ErrUnsupportedType)
+}
+
 func TestWithString(t *testing.T) {
 	cases := []testutils.TestCase[string]{
 		{
@@ -88,6 +154,71 @@ func TestWithBool(t *testing.T) {
 	}
 }
 
+func TestWithBool_Vocabulary(t *testing.T) {
+	cases := []testutils.TestCase[bool]{
+		{Name: "accepts yes", Given: "yes", Expected: true},
+		{Name: "accepts on regardless of its case", Given: "ON", Expected: true},
+		{Name: "accepts enabled surrounded by whitespaces", Given: " Enabled\n", Expected: true},
+		{Name: "accepts y", Given: "y", Expected: true},
+		{Name: "accepts no", Given: "No", Expected: false},
+		{Name: "accepts off", Given: "off", Expected: false},
+		{Name: "accepts disabled", Given: "DISABLED", Expected: false},
+		{Name: "accepts the strconv values", Given: "F", Expected: false},
+		{Name: "returns an error on unknown values", Given: "maybe", ShouldFail: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Setenv("FOO", tc.Given)
+
+			received, err := WithBool("FOO")
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+				assert.Equal(t, tc.Expected, WithDefaultBool("FOO", !tc.Expected))
+			}
+		})
+	}
+}
+
+func TestWithBool_Options(t *testing.T) {
+	t.Setenv("FOO", "yes")
+	t.Setenv("BAR", "Oui")
+
+	_, err := WithBool("FOO", StrictBool())
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Equal(t, false, WithDefaultBool("FOO", false, StrictBool()))
+
+	custom := BoolValues([]string{"oui"}, []string{"non"})
+
+	bar, err := WithBool("BAR", custom)
+	assert.NoError(t, err)
+	assert.True(t, bar)
+
+	_, err = WithBool("FOO", custom)
+	assert.ErrorContains(t, err, `FOO: could not parse boolean: "yes" is not one of oui or non`)
+
+	oneSided := BoolValues([]string{"oui"}, nil)
+
+	bar, err = WithBool("BAR", oneSided)
+	assert.NoError(t, err)
+	assert.True(t, bar)
+
+	t.Setenv("BAZ", "off")
+	assert.Equal(t, false, WithDefaultBool("BAZ", true, oneSided))
+	assert.Equal(t, true, WithDefaultBool("FOO", true, oneSided))
+
+	_, err = WithBool("FOO", BoolValues([]string{"oui"}, []string{}))
+	assert.ErrorContains(t, err, `"yes" is not one of oui or 0, f, false, n, no, off, disable, disabled`)
+
+	flags, err := NewLoader(Map{"FLAGS": "on,off,yes"}).WithBoolSlice("FLAGS")
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, flags)
+}
+
 func TestWithInt(t *testing.T) {
 	cases := []testutils.TestCase[int]{
 		{
@@ -402,3 +533,471 @@ func TestWithFloat64(t *testing.T) {
 		})
 	}
 }
+
+func TestWithDuration(t *testing.T) {
+	cases := []testutils.TestCase[time.Duration]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 90 * time.Second,
+			Env: map[string]string{
+				"FOO": "1m30s",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value is not a duration",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "30",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithDuration(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithTime(t *testing.T) {
+	cases := []testutils.TestCase[time.Time]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: time.Date(2024, time.May, 1, 12, 30, 0, 0, time.UTC),
+			Env: map[string]string{
+				"FOO": "2024-05-01T12:30:00Z",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value does not match the layout",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "2024-05-01",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithTime(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithTime_Layout(t *testing.T) {
+	t.Setenv("FOO", "2024-05-01")
+
+	received, err := WithTime("FOO", Layout(time.DateOnly))
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), received)
+}
+
+func TestWithUint(t *testing.T) {
+	cases := []testutils.TestCase[uint]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 4294967295,
+			Env: map[string]string{
+				"FOO": "4294967295",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value is negative",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "-1",
+			},
+		},
+		{
+			Name:       "returns an error if the value is not an unsigned integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithUint(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithUint8(t *testing.T) {
+	cases := []testutils.TestCase[uint8]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 255,
+			Env: map[string]string{
+				"FOO": "255",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value is negative",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "-1",
+			},
+		},
+		{
+			Name:       "returns an error if the value is not an unsigned integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithUint8(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithUint16(t *testing.T) {
+	cases := []testutils.TestCase[uint16]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 65535,
+			Env: map[string]string{
+				"FOO": "65535",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value is negative",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "-1",
+			},
+		},
+		{
+			Name:       "returns an error if the value is not an unsigned integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithUint16(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithUint32(t *testing.T) {
+	cases := []testutils.TestCase[uint32]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 4294967295,
+			Env: map[string]string{
+				"FOO": "4294967295",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value is negative",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "-1",
+			},
+		},
+		{
+			Name:       "returns an error if the value is not an unsigned integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithUint32(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithUint64(t *testing.T) {
+	cases := []testutils.TestCase[uint64]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 18446744073709551615,
+			Env: map[string]string{
+				"FOO": "18446744073709551615",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value is negative",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "-1",
+			},
+		},
+		{
+			Name:       "returns an error if the value is not an unsigned integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithUint64(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithUintptr(t *testing.T) {
+	cases := []testutils.TestCase[uintptr]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 4294967295,
+			Env: map[string]string{
+				"FOO": "4294967295",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value is negative",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "-1",
+			},
+		},
+		{
+			Name:       "returns an error if the value is not an unsigned integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithUintptr(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithUint_DescriptiveErrors(t *testing.T) {
+	t.Setenv("NEGATIVE", "-1")
+	t.Setenv("OVERFLOW", "256")
+
+	_, err := WithUint8("NEGATIVE")
+	assert.ErrorContains(t, err, "could not parse uint8: negative value \"-1\" is not allowed")
+
+	_, err = WithUint8("OVERFLOW")
+	assert.ErrorIs(t, err, strconv.ErrRange)
+	assert.ErrorContains(t, err, "could not parse uint8: value exceeds the maximum of 255")
+
+	t.Setenv("HUGE", "18446744073709551616")
+
+	_, err = WithUint("HUGE")
+	assert.ErrorContains(t, err, "could not parse uint: value exceeds the maximum of 18446744073709551615")
+
+	_, err = WithUintptr("NEGATIVE")
+	assert.ErrorContains(t, err, "could not parse uintptr: negative value \"-1\" is not allowed")
+}
+
+func TestWithString_AllowEmpty(t *testing.T) {
+	t.Setenv("FOO", "")
+
+	received, err := WithString("FOO", AllowEmpty())
+	assert.NoError(t, err)
+	assert.Empty(t, received)
+
+	_, err = WithInt("FOO", AllowEmpty())
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrEmptyVariable)
+
+	_, err = WithString("UNSET", AllowEmpty())
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+}
+
+func TestNumericGetters_ReturnMinusOneOnError(t *testing.T) {
+	t.Setenv("INVALID", "notanumber")
+
+	i, err := WithInt("INVALID")
+	assert.Error(t, err)
+	assert.Equal(t, -1, i)
+
+	i64, err := WithInt64("UNSET")
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+	assert.Equal(t, int64(-1), i64)
+
+	f, err := NewLoader(Map{"RATIO": "half"}).WithFloat32("RATIO")
+	assert.Error(t, err)
+	assert.Equal(t, float32(-1), f)
+}
@@ -0,0 +1,47 @@
+package env
+
+import "fmt"
+
+// VarError records a failure to retrieve or parse a variable. Every getter of
+// the package returns its errors as a *VarError.
+//
+// errors.Is and errors.As match the underlying error, such as ErrUndefinedVariable,
+// ErrEmptyVariable or a *strconv.NumError.
+type VarError struct {
+	// Key is the name of the variable.
+	Key string
+	// Value is the raw value of the variable, empty if the variable is undefined
+	// or if the error has been redacted.
+	Value string
+	// Type is the name of the type the value was parsed into, e.g. "int" or "[]string".
+	Type string
+	// Err is the underlying error.
+	Err error
+
+	redacted bool
+}
+
+func (e *VarError) Error() string {
+	if e.redacted && e.Err != ErrUndefinedVariable && e.Err != ErrEmptyVariable {
+		return fmt.Sprintf("%s: invalid %s value (redacted)", e.Key, e.Type)
+	}
+	return fmt.Sprintf("%s: %v", e.Key, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *VarError) Unwrap() error {
+	return e.Err
+}
+
+// Redact removes the raw value from the error, so that it can be logged without
+// leaking sensitive data. As the underlying error may echo the value, the message
+// of a redacted error only mentions the key and the type, unless the underlying
+// error is ErrUndefinedVariable or ErrEmptyVariable. errors.Is and errors.As
+// still match the underlying error.
+//
+// Errors of variables retrieved as a Secret, or with the Sensitive option, are
+// always redacted.
+func (e *VarError) Redact() {
+	e.Value = ""
+	e.redacted = true
+}
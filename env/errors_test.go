@@ -0,0 +1,84 @@
+package env
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestVarError(t *testing.T) {
+	l := NewLoader(Map{"PORT": "80a0", "EMPTY": ""})
+
+	cases := []struct {
+		Name     string
+		Get      func() error
+		Expected VarError
+		Error    error
+	}{
+		{
+			Name: "carries the key of an undefined variable",
+			Get: func() error {
+				_, err := l.WithString("UNSET")
+				return err
+			},
+			Expected: VarError{Key: "UNSET", Type: "string", Err: ErrUndefinedVariable},
+			Error:    ErrUndefinedVariable,
+		},
+		{
+			Name: "carries the key of an empty variable",
+			Get: func() error {
+				_, err := l.WithInt("EMPTY")
+				return err
+			},
+			Expected: VarError{Key: "EMPTY", Type: "int", Err: ErrEmptyVariable},
+			Error:    ErrEmptyVariable,
+		},
+		{
+			Name: "carries the key, the value and the type of an unparsable variable",
+			Get: func() error {
+				_, err := l.WithUint16Slice("PORT")
+				return err
+			},
+			Expected: VarError{Key: "PORT", Value: "80a0", Type: "[]uint16"},
+			Error:    strconv.ErrSyntax,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := tc.Get()
+
+			var varErr *VarError
+			assert.True(t, errors.As(err, &varErr))
+			assert.Equal(t, tc.Expected.Key, varErr.Key)
+			assert.Equal(t, tc.Expected.Value, varErr.Value)
+			assert.Equal(t, tc.Expected.Type, varErr.Type)
+			assert.ErrorIs(t, err, tc.Error)
+			assert.ErrorContains(t, err, tc.Expected.Key+": ")
+		})
+	}
+}
+
+func TestVarError_MatchesNumError(t *testing.T) {
+	_, err := NewLoader(Map{"PORT": "80a0"}).WithInt("PORT")
+
+	var numErr *strconv.NumError
+	assert.True(t, errors.As(err, &numErr))
+	assert.Equal(t, "80a0", numErr.Num)
+}
+
+func TestVarError_Redact(t *testing.T) {
+	_, err := NewLoader(Map{"TOKEN": "s3cr3t"}).WithInt("TOKEN")
+
+	var varErr *VarError
+	assert.True(t, errors.As(err, &varErr))
+	assert.ErrorContains(t, err, "s3cr3t")
+
+	varErr.Redact()
+
+	assert.Empty(t, varErr.Value)
+	assert.NotContains(t, err.Error(), "s3cr3t")
+	assert.EqualError(t, err, "TOKEN: invalid int value (redacted)")
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
@@ -18,12 +18,17 @@ func Example() {
 	strVal, _ := env.WithString("FOO_STRING")
 	intVal, _ := env.WithInt("FOO_INT")
 
-	fmt.Printf("FOO_STRING: %s\n", strVal) // Output: FOO_STRING: hello
-	fmt.Printf("FOO_INT: %d\n", intVal)    // Output: FOO_INT: 10
+	fmt.Printf("FOO_STRING: %s\n", strVal)
+	fmt.Printf("FOO_INT: %d\n", intVal)
 
 	// In case you want to automatically fall back to a default value if the environment variable
 	// is not set or if the parsing of the value into the wanted type fails, you can use the env.WithDefault* functions.
 	fallbackVal := env.WithDefaultString("FOO_UNSET", "myfallbackvalue")
 
-	fmt.Printf("FOO_UNSET: %s\n", fallbackVal) // Output: FOO_UNSET: hello
+	fmt.Printf("FOO_UNSET: %s\n", fallbackVal)
+
+	// Output:
+	// FOO_STRING: hello
+	// FOO_INT: 10
+	// FOO_UNSET: myfallbackvalue
 }
@@ -0,0 +1,178 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrExpansionCycle   = errors.New("variable expansion cycle")
+	ErrRequiredVariable = errors.New("required variable is missing")
+)
+
+// expander expands the references to other variables of a value, as enabled by
+// the Expand option. It keeps track of the variables being expanded to detect cycles.
+type expander struct {
+	source Source
+	stack  []string
+}
+
+// expand replaces the references to other variables in s by their value, as
+// described by the Expand option.
+func (e *expander) expand(s string) (string, error) {
+	var sb strings.Builder
+	for i := 0; i < len(s); {
+		c := s[i]
+		next := byte(0)
+		if i+1 < len(s) {
+			next = s[i+1]
+		}
+
+		switch {
+		case (c == '$' || c == '\\') && next == '$':
+			sb.WriteByte('$')
+			i += 2
+		case c == '$' && next == '{':
+			end := closingBrace(s, i+2)
+			if end < 0 {
+				return "", fmt.Errorf("unterminated expansion %q", s[i:])
+			}
+			val, err := e.expandBraced(s[i+2 : end])
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(val)
+			i = end + 1
+		case c == '$' && isNameChar(next, true):
+			end := i + 1
+			for end < len(s) && isNameChar(s[end], false) {
+				end++
+			}
+			val, _, err := e.resolve(s[i+1 : end])
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(val)
+			i = end
+		default:
+			sb.WriteByte(c)
+			i++
+		}
+	}
+	return sb.String(), nil
+}
+
+// expandBraced expands the content of a ${...} expression.
+func (e *expander) expandBraced(expr string) (string, error) {
+	end := 0
+	for end < len(expr) && isNameChar(expr[end], end == 0) {
+		end++
+	}
+	name, op := expr[:end], expr[end:]
+	if name == "" {
+		return "", fmt.Errorf("invalid expansion ${%s}: missing variable name", expr)
+	}
+
+	val, ok, err := e.resolve(name)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case op == "":
+		return val, nil
+	case strings.HasPrefix(op, ":-"):
+		if !ok || val == "" {
+			return e.expand(op[2:])
+		}
+		return val, nil
+	case strings.HasPrefix(op, "-"):
+		if !ok {
+			return e.expand(op[1:])
+		}
+		return val, nil
+	case strings.HasPrefix(op, ":?"):
+		if !ok || val == "" {
+			return "", e.required(name, op[2:], ok)
+		}
+		return val, nil
+	case strings.HasPrefix(op, "?"):
+		if !ok {
+			return "", e.required(name, op[1:], ok)
+		}
+		return val, nil
+	default:
+		return "", fmt.Errorf("invalid expansion ${%s}: unknown operator %q", expr, op)
+	}
+}
+
+// required returns the error of a ${VAR:?message} expression whose variable is
+// unset, or empty if defined is true. It wraps ErrRequiredVariable rather than
+// ErrUndefinedVariable or ErrEmptyVariable, which describe the expanded variable itself.
+func (e *expander) required(name, message string, defined bool) error {
+	msg, err := e.expand(message)
+	if err != nil {
+		return err
+	}
+	if msg == "" {
+		msg = "variable is undefined"
+		if defined {
+			msg = "variable is empty"
+		}
+	}
+	return fmt.Errorf("could not expand %s: %w: %s", name, ErrRequiredVariable, msg)
+}
+
+// resolve returns the expanded value of the variable identified by the name, and
+// whether it is defined. ErrExpansionCycle is returned if the variable is already
+// being expanded.
+func (e *expander) resolve(name string) (string, bool, error) {
+	for i, key := range e.stack {
+		if key == name {
+			cycle := append(e.stack[i:len(e.stack):len(e.stack)], name)
+			return "", false, fmt.Errorf("%w: %s", ErrExpansionCycle, strings.Join(cycle, " -> "))
+		}
+	}
+
+	val, ok := e.source.Lookup(name)
+	if !ok {
+		return "", false, nil
+	}
+
+	e.stack = append(e.stack, name)
+	defer func() { e.stack = e.stack[:len(e.stack)-1] }()
+
+	expanded, err := e.expand(val)
+	return expanded, true, err
+}
+
+// closingBrace returns the index of the brace closing the expression starting at
+// start, taking nested expressions into account, or -1 if there is none.
+func closingBrace(s string, start int) int {
+	depth := 1
+	for i := start; i < len(s); i++ {
+		switch {
+		case s[i] == '{' && i > 0 && s[i-1] == '$':
+			depth++
+		case s[i] == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// isNameChar reports whether c can be used in the name of a referenced variable.
+func isNameChar(c byte, first bool) bool {
+	switch {
+	case c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	default:
+		return false
+	}
+}
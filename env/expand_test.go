@@ -0,0 +1,156 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	cases := []testutils.TestCase[string]{
+		{
+			Name:     "expands braced and bare references",
+			Given:    "postgres://${DB_USER}@$DB_HOST/app",
+			Expected: "postgres://admin@localhost/app",
+		},
+		{
+			Name:     "expands references recursively",
+			Given:    "${URL}/health",
+			Expected: "http://localhost:8080/health",
+		},
+		{
+			Name:     "expands unset references to an empty string",
+			Given:    "[${UNSET}]",
+			Expected: "[]",
+		},
+		{
+			Name:     "applies the default if the reference is unset or empty",
+			Given:    "${UNSET:-a} ${EMPTY:-b} ${DB_HOST:-c}",
+			Expected: "a b localhost",
+		},
+		{
+			Name:     "applies the default only if the reference is unset",
+			Given:    "${UNSET-a} [${EMPTY-b}]",
+			Expected: "a []",
+		},
+		{
+			Name:     "expands nested defaults",
+			Given:    "${UNSET:-${ALSO_UNSET:-$DB_HOST}}",
+			Expected: "localhost",
+		},
+		{
+			Name:     "supports escaped dollar signs",
+			Given:    `$$HOME \${DB_HOST} cost: 5$`,
+			Expected: "$HOME ${DB_HOST} cost: 5$",
+		},
+		{
+			Name:     "does not fail on set references with an error operator",
+			Given:    "${DB_HOST:?required} ${EMPTY?required}",
+			Expected: "localhost ",
+		},
+		{
+			Name:       "fails if a required reference is unset",
+			Given:      "${UNSET:?is required}",
+			ShouldFail: true,
+			Error:      ErrRequiredVariable,
+		},
+		{
+			Name:       "fails if a required reference is empty",
+			Given:      "${EMPTY:?}",
+			ShouldFail: true,
+			Error:      ErrRequiredVariable,
+		},
+		{
+			Name:       "fails on cycles",
+			Given:      "${CYCLE_A}",
+			ShouldFail: true,
+			Error:      ErrExpansionCycle,
+		},
+		{
+			Name:       "fails on unterminated expressions",
+			Given:      "${DB_HOST",
+			ShouldFail: true,
+		},
+		{
+			Name:       "fails on unknown operators",
+			Given:      "${DB_HOST:+alt}",
+			ShouldFail: true,
+		},
+	}
+
+	source := Map{
+		"DB_USER": "admin",
+		"DB_HOST": "localhost",
+		"URL":     "http://${DB_HOST}:${PORT:-8080}",
+		"EMPTY":   "",
+		"CYCLE_A": "${CYCLE_B}",
+		"CYCLE_B": "$CYCLE_A",
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			e := &expander{source: source}
+
+			received, err := e.expand(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithString_Expand(t *testing.T) {
+	t.Setenv("DB_USER", "admin")
+	t.Setenv("DB_HOST", "localhost")
+	t.Setenv("DATABASE_URL", "postgres://${DB_USER}@${DB_HOST}/app")
+
+	received, err := WithString("DATABASE_URL", Expand())
+	assert.NoError(t, err)
+	assert.Equal(t, "postgres://admin@localhost/app", received)
+
+	raw, err := WithString("DATABASE_URL")
+	assert.NoError(t, err)
+	assert.Equal(t, "postgres://${DB_USER}@${DB_HOST}/app", raw)
+}
+
+func TestLoader_Expand(t *testing.T) {
+	l := NewLoader(Map{
+		"PORT":     "${BASE_PORT:-8000}",
+		"SELF":     "${SELF}",
+		"REQUIRED": "${UNSET:?must be set}",
+		"EMPTY":    "${UNSET}",
+	}, Expand())
+
+	port, err := l.WithInt("PORT")
+	assert.NoError(t, err)
+	assert.Equal(t, 8000, port)
+
+	_, err = l.WithString("SELF")
+	assert.ErrorIs(t, err, ErrExpansionCycle)
+	assert.ErrorContains(t, err, "SELF -> SELF")
+
+	_, err = l.WithString("REQUIRED")
+	assert.ErrorContains(t, err, "REQUIRED: could not expand value: could not expand UNSET")
+	assert.ErrorContains(t, err, "must be set")
+
+	_, err = l.WithString("EMPTY")
+	assert.ErrorIs(t, err, ErrEmptyVariable)
+}
+
+func TestGetOrStrict_ExpandRequired(t *testing.T) {
+	l := NewLoader(Map{"URL": "http://${HOST:?HOST is required}/x"}, Expand())
+
+	received, err := GetOrStrictFrom(l, "URL", "fallback")
+
+	assert.Empty(t, received)
+	assert.ErrorIs(t, err, ErrRequiredVariable)
+	assert.NotErrorIs(t, err, ErrUndefinedVariable)
+	assert.EqualError(t, err, "URL: could not expand value: could not expand HOST: required variable is missing: HOST is required")
+}
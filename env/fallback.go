@@ -1,109 +1,164 @@
 package env
 
+import "time"
+
+// GetOr retrieves the value of an environment variable identified by the key
+// and parses it into T. If the environment variable is not set, empty or cannot
+// be parsed, it returns the fallback value provided. With the AllowEmpty option,
+// an empty variable is parsed instead, so that for instance
+//
+//	env.WithDefaultString("PREFIX", "app_", env.AllowEmpty())
+//
+// returns "app_" if PREFIX is unset, but "" if PREFIX is set to an empty value.
+//
+// This method is a convenience wrapper around Get to silent the error and return a fallback value.
+func GetOr[T any](key string, fallback T, opts ...Option) T {
+	return GetOrFrom(std, key, fallback, opts...)
+}
+
+// GetOrStrict retrieves the value of an environment variable identified by the key
+// and parses it into T. If the environment variable is not set or empty, it returns
+// the fallback value provided. Unlike GetOr, any other error, such as a parsing
+// failure, is returned instead of being silently replaced by the fallback value:
+//
+//	port, err := env.GetOrStrict("PORT", 8080) // PORT=80a0 returns an error.
+func GetOrStrict[T any](key string, fallback T, opts ...Option) (T, error) {
+	return GetOrStrictFrom(std, key, fallback, opts...)
+}
+
 // WithDefaultString retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default string provided.
 //
 // This method is a convenience wrapper around WithString to silent the error and return a fallback value.
-func WithDefaultString(key string, fallback string) string {
-	val, err := WithString(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultString(key string, fallback string, opts ...Option) string {
+	return GetOr(key, fallback, opts...)
 }
 
 // WithDefaultBool retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default bool provided.
+// Values are parsed the same way WithBool does, with the same vocabulary.
 //
 // This method is a convenience wrapper around WithBool to silent the error and return a fallback value.
-func WithDefaultBool(key string, fallback bool) bool {
-	val, err := WithBool(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultBool(key string, fallback bool, opts ...Option) bool {
+	return GetOr(key, fallback, opts...)
 }
 
 // WithDefaultInt retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default int provided.
 //
 // This method is a convenience wrapper around WithInt to silent the error and return a fallback value.
-func WithDefaultInt(key string, fallback int) int {
-	val, err := WithInt(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultInt(key string, fallback int, opts ...Option) int {
+	return GetOr(key, fallback, opts...)
 }
 
 // WithDefaultInt8 retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default int8 provided.
 //
 // This method is a convenience wrapper around WithInt8 to silent the error and return a fallback value.
-func WithDefaultInt8(key string, fallback int8) int8 {
-	val, err := WithInt8(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultInt8(key string, fallback int8, opts ...Option) int8 {
+	return GetOr(key, fallback, opts...)
 }
 
 // WithDefaultInt16 retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default int16 provided.
 //
 // This method is a convenience wrapper around WithInt16 to silent the error and return a fallback value.
-func WithDefaultInt16(key string, fallback int16) int16 {
-	val, err := WithInt16(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultInt16(key string, fallback int16, opts ...Option) int16 {
+	return GetOr(key, fallback, opts...)
 }
 
 // WithDefaultInt32 retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default int32 provided.
 //
 // This method is a convenience wrapper around WithInt32 to silent the error and return a fallback value.
-func WithDefaultInt32(key string, fallback int32) int32 {
-	val, err := WithInt32(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultInt32(key string, fallback int32, opts ...Option) int32 {
+	return GetOr(key, fallback, opts...)
 }
 
 // WithDefaultInt64 retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default int64 provided.
 //
 // This method is a convenience wrapper around WithInt8 to silent the error and return a fallback value.
-func WithDefaultInt64(key string, fallback int64) int64 {
-	val, err := WithInt64(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultInt64(key string, fallback int64, opts ...Option) int64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default uint provided.
+//
+// This method is a convenience wrapper around WithUint to silent the error and return a fallback value.
+func WithDefaultUint(key string, fallback uint, opts ...Option) uint {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint8 retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default uint8 provided.
+//
+// This method is a convenience wrapper around WithUint8 to silent the error and return a fallback value.
+func WithDefaultUint8(key string, fallback uint8, opts ...Option) uint8 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint16 retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default uint16 provided.
+//
+// This method is a convenience wrapper around WithUint16 to silent the error and return a fallback value.
+func WithDefaultUint16(key string, fallback uint16, opts ...Option) uint16 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint32 retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default uint32 provided.
+//
+// This method is a convenience wrapper around WithUint32 to silent the error and return a fallback value.
+func WithDefaultUint32(key string, fallback uint32, opts ...Option) uint32 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint64 retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default uint64 provided.
+//
+// This method is a convenience wrapper around WithUint64 to silent the error and return a fallback value.
+func WithDefaultUint64(key string, fallback uint64, opts ...Option) uint64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUintptr retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default uintptr provided.
+//
+// This method is a convenience wrapper around WithUintptr to silent the error and return a fallback value.
+func WithDefaultUintptr(key string, fallback uintptr, opts ...Option) uintptr {
+	return GetOr(key, fallback, opts...)
 }
 
 // WithDefaultFloat32 retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default int provided.
 //
 // This method is a convenience wrapper around WithFloat32 to silent the error and return a fallback value.
-func WithDefaultFloat32(key string, fallback float32) float32 {
-	val, err := WithFloat32(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultFloat32(key string, fallback float32, opts ...Option) float32 {
+	return GetOr(key, fallback, opts...)
 }
 
 // WithDefaultFloat64 retrieves the value of an environment variable identified by the key.
 // If the environment variable is not set or empty, it returns the fallback default int provided.
 //
 // This method is a convenience wrapper around WithFloat64 to silent the error and return a fallback value.
-func WithDefaultFloat64(key string, fallback float64) float64 {
-	val, err := WithFloat64(key)
-	if err != nil {
-		return fallback
-	}
-	return val
+func WithDefaultFloat64(key string, fallback float64, opts ...Option) float64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultDuration retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default time.Duration provided.
+//
+// This method is a convenience wrapper around WithDuration to silent the error and return a fallback value.
+func WithDefaultDuration(key string, fallback time.Duration, opts ...Option) time.Duration {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultTime retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default time.Time provided.
+//
+// This method is a convenience wrapper around WithTime to silent the error and return a fallback value.
+func WithDefaultTime(key string, fallback time.Time, opts ...Option) time.Time {
+	return GetOr(key, fallback, opts...)
 }
@@ -3,9 +3,46 @@ package env
 import (
 	"github.com/stretchr/testify/assert"
 	"github.com/thomasgouveia/goutils/env/testutils"
+	"strconv"
 	"testing"
+	"time"
 )
 
+func TestGetOr(t *testing.T) {
+	type port int
+
+	cases := []testutils.TestCase[port]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 8080,
+			Env: map[string]string{
+				"FOO": "8080",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: 3000,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: 3000,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, GetOr(tc.Given, tc.Expected))
+		})
+	}
+}
+
 func TestWithDefaultString(t *testing.T) {
 	cases := []testutils.TestCase[string]{
 		{
@@ -294,3 +331,345 @@ func TestWithDefaultFloat64(t *testing.T) {
 		})
 	}
 }
+
+func TestWithDefaultDuration(t *testing.T) {
+	cases := []testutils.TestCase[time.Duration]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 30 * time.Second,
+			Env: map[string]string{
+				"FOO": "30s",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: time.Minute,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: time.Minute,
+			Env: map[string]string{
+				"FOO": "notaduration",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultDuration(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestWithDefaultTime(t *testing.T) {
+	cases := []testutils.TestCase[time.Time]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC),
+			Env: map[string]string{
+				"FOO": "2024-05-01T00:00:00Z",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC),
+			Env: map[string]string{
+				"FOO": "notatime",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultTime(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestWithDefaultUint(t *testing.T) {
+	cases := []testutils.TestCase[uint]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "10",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: 10,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "-1",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultUint(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestWithDefaultUint8(t *testing.T) {
+	cases := []testutils.TestCase[uint8]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "10",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: 10,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "256",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultUint8(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestWithDefaultUint16(t *testing.T) {
+	cases := []testutils.TestCase[uint16]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "10",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: 10,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "65536",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultUint16(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestWithDefaultUint32(t *testing.T) {
+	cases := []testutils.TestCase[uint32]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "10",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: 10,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "4294967296",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultUint32(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestWithDefaultUint64(t *testing.T) {
+	cases := []testutils.TestCase[uint64]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "10",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: 10,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "18446744073709551616",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultUint64(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestWithDefaultUintptr(t *testing.T) {
+	cases := []testutils.TestCase[uintptr]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "10",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: 10,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "-10",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultUintptr(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestWithDefaultString_AllowEmpty(t *testing.T) {
+	cases := []testutils.TestCase[string]{
+		{
+			Name:     "returns the empty value if the env var is set but empty",
+			Given:    "FOO",
+			Expected: "",
+			Env: map[string]string{
+				"FOO": "",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: "fallback",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultString(tc.Given, "fallback", AllowEmpty()))
+		})
+	}
+}
+
+func TestGetOrStrict(t *testing.T) {
+	cases := []testutils.TestCase[int]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: 80,
+			Env: map[string]string{
+				"FOO": "80",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: 8080,
+		},
+		{
+			Name:     "returns the fallback value if the env var is empty",
+			Given:    "FOO",
+			Expected: 8080,
+			Env: map[string]string{
+				"FOO": "",
+			},
+		},
+		{
+			Name:       "returns an error if the parsing fails",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      strconv.ErrSyntax,
+			Env: map[string]string{
+				"FOO": "80a0",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := GetOrStrict(tc.Given, 8080)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
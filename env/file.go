@@ -0,0 +1,35 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// DefaultMaxFileSize is the maximum size of the files read with the FileFallback option,
+// unless another one is set with MaxFileSize.
+const DefaultMaxFileSize = 1 << 20
+
+var ErrConflictingVariables = errors.New("variable and its file variant are both set")
+
+// readFile returns the content of the file at the given path, without its trailing
+// newlines. An error is returned if the file is larger than maxSize bytes.
+func readFile(path string, maxSize int64) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("could not read file: %w", err)
+	}
+	defer f.Close()
+
+	content, err := io.ReadAll(io.LimitReader(f, maxSize+1))
+	if err != nil {
+		return "", fmt.Errorf("could not read file %s: %w", path, err)
+	}
+	if int64(len(content)) > maxSize {
+		return "", fmt.Errorf("could not read file %s: size exceeds the maximum of %d bytes", path, maxSize)
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
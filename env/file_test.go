@@ -0,0 +1,121 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileFallback(t *testing.T) {
+	dir := t.TempDir()
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+		return path
+	}
+
+	password := writeFile("password", "s3cr3t\n\n")
+	empty := writeFile("empty", "\n")
+
+	cases := []testutils.TestCase[string]{
+		{
+			Name:     "reads the value from the file",
+			Given:    "DB_PASSWORD",
+			Expected: "s3cr3t",
+			Env: map[string]string{
+				"DB_PASSWORD_FILE": password,
+			},
+		},
+		{
+			Name:     "reads the value from the variable",
+			Given:    "DB_PASSWORD",
+			Expected: "plain",
+			Env: map[string]string{
+				"DB_PASSWORD": "plain",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if neither variable is defined",
+			Given:      "DB_PASSWORD",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns ErrConflictingVariables if both variables are defined",
+			Given:      "DB_PASSWORD",
+			ShouldFail: true,
+			Error:      ErrConflictingVariables,
+			Env: map[string]string{
+				"DB_PASSWORD":      "plain",
+				"DB_PASSWORD_FILE": password,
+			},
+		},
+		{
+			Name:       "returns ErrEmptyVariable if the file is empty",
+			Given:      "DB_PASSWORD",
+			ShouldFail: true,
+			Error:      ErrEmptyVariable,
+			Env: map[string]string{
+				"DB_PASSWORD_FILE": empty,
+			},
+		},
+		{
+			Name:       "returns os.ErrNotExist if the file does not exist",
+			Given:      "DB_PASSWORD",
+			ShouldFail: true,
+			Error:      os.ErrNotExist,
+			Env: map[string]string{
+				"DB_PASSWORD_FILE": filepath.Join(dir, "missing"),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithString(tc.Given, FileFallback())
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestFileFallback_Options(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "port")
+	assert.NoError(t, os.WriteFile(path, []byte("8080\n"), 0o600))
+
+	l := NewLoader(Map{"PORT_PATH": path}, FileSuffix("_PATH"))
+
+	port, err := l.WithInt("PORT")
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, port)
+
+	_, err = l.WithInt("PORT", MaxFileSize(4))
+	assert.ErrorContains(t, err, "size exceeds the maximum of 4 bytes")
+
+	_, err = l.WithInt("PORT", FileSuffix(""))
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+}
+
+func TestBind_FileTagOption(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "password")
+	assert.NoError(t, os.WriteFile(path, []byte("s3cr3t\n"), 0o600))
+
+	var cfg struct {
+		Password string `env:"PASSWORD,file"`
+	}
+
+	assert.NoError(t, NewLoader(Map{"PASSWORD_FILE": path}).Bind(&cfg))
+	assert.Equal(t, "s3cr3t", cfg.Password)
+}
@@ -1,10 +1,59 @@
 package env
 
 import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 )
 
+// Default vocabulary of the boolean values, on top of the ones understood by
+// strconv.ParseBool, as described by the BoolValues option.
+var (
+	defaultTrueValues  = []string{"1", "t", "true", "y", "yes", "on", "enable", "enabled"}
+	defaultFalseValues = []string{"0", "f", "false", "n", "no", "off", "disable", "disabled"}
+)
+
+func parseBool(v string, o *options) (bool, error) {
+	if o.strictBool {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("could not parse boolean: %w", err)
+		}
+		return parsed, nil
+	}
+
+	trueValues, falseValues := defaultTrueValues, defaultFalseValues
+	if len(o.trueValues) > 0 {
+		trueValues = o.trueValues
+	}
+	if len(o.falseValues) > 0 {
+		falseValues = o.falseValues
+	}
+
+	word := strings.TrimSpace(v)
+	isWord := func(s string) bool { return strings.EqualFold(s, word) }
+	switch {
+	case slices.ContainsFunc(trueValues, isWord):
+		return true, nil
+	case slices.ContainsFunc(falseValues, isWord):
+		return false, nil
+	default:
+		return false, fmt.Errorf("could not parse boolean: %q is not one of %s or %s",
+			v, strings.Join(trueValues, ", "), strings.Join(falseValues, ", "))
+	}
+}
+
 func parseFloat(v string, bitSize int) (float64, error) {
 	parsed, err := strconv.ParseFloat(v, bitSize)
 	if err != nil {
@@ -20,3 +69,253 @@ func parseInt(v string, base, bitSize int) (int64, error) {
 	}
 	return parsed, nil
 }
+
+func parseUint(v string, base, bitSize int) (uint64, error) {
+	name := "uint"
+	if bitSize != 0 {
+		name = fmt.Sprintf("uint%d", bitSize)
+	}
+	return parseUnsigned(v, base, bitSize, name)
+}
+
+func parseUintptr(v string) (uint64, error) {
+	return parseUnsigned(v, 0, 0, "uintptr")
+}
+
+// parseUnsigned parses an unsigned integer of the given bit size, where 0 stands
+// for the size of uint, naming it after the Go type in the errors.
+func parseUnsigned(v string, base, bitSize int, name string) (uint64, error) {
+	if strings.HasPrefix(v, "-") {
+		return 0, fmt.Errorf("could not parse %s: negative value %q is not allowed", name, v)
+	}
+
+	parsed, err := strconv.ParseUint(v, base, bitSize)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("could not parse %s: value exceeds the maximum of %d: %w", name, maxUint(bitSize), err)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("could not parse %s: %w", name, err)
+	}
+	return parsed, nil
+}
+
+// maxUint returns the maximum value of an unsigned integer of the given bit size,
+// where 0 stands for the size of uint.
+func maxUint(bitSize int) uint64 {
+	if bitSize == 0 {
+		bitSize = strconv.IntSize
+	}
+	if bitSize >= 64 {
+		return math.MaxUint64
+	}
+	return 1<<bitSize - 1
+}
+
+func parseDuration(v string) (time.Duration, error) {
+	parsed, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse duration: %w", err)
+	}
+	return parsed, nil
+}
+
+func parseTime(v, layout string) (time.Time, error) {
+	parsed, err := time.Parse(layout, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("could not parse time: %w", err)
+	}
+	return parsed, nil
+}
+
+// byteUnits maps the lower-cased suffixes of byte sizes to their multiplier.
+var byteUnits = map[string]uint64{
+	"": 1, "b": 1,
+	"k": 1e3, "kb": 1e3, "ki": 1 << 10, "kib": 1 << 10,
+	"m": 1e6, "mb": 1e6, "mi": 1 << 20, "mib": 1 << 20,
+	"g": 1e9, "gb": 1e9, "gi": 1 << 30, "gib": 1 << 30,
+	"t": 1e12, "tb": 1e12, "ti": 1 << 40, "tib": 1 << 40,
+	"p": 1e15, "pb": 1e15, "pi": 1 << 50, "pib": 1 << 50,
+	"e": 1e18, "eb": 1e18, "ei": 1 << 60, "eib": 1 << 60,
+}
+
+func parseBytes(v string) (uint64, error) {
+	v = strings.TrimSpace(v)
+	if strings.HasPrefix(v, "-") {
+		return 0, fmt.Errorf("could not parse byte size: negative value %q is not allowed", v)
+	}
+
+	end := strings.IndexFunc(v, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+	if end < 0 {
+		end = len(v)
+	}
+	num, unit := v[:end], strings.ToLower(strings.TrimSpace(v[end:]))
+
+	mult, ok := byteUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("could not parse byte size: unknown unit %q", v[end:])
+	}
+
+	size, ok := new(big.Rat).SetString(num)
+	if !ok || num == "" || strings.Count(num, ".") > 1 {
+		return 0, fmt.Errorf("could not parse byte size: invalid number %q", num)
+	}
+
+	if mult == 1 && !size.IsInt() {
+		return 0, fmt.Errorf("could not parse byte size: %q is not a whole number of bytes", v)
+	}
+
+	// Fractions of a byte resulting from a unit, e.g. 0.1KiB, are truncated.
+	bytes := new(big.Int).Quo(new(big.Int).Mul(size.Num(), new(big.Int).SetUint64(mult)), size.Denom())
+	if !bytes.IsUint64() {
+		return 0, fmt.Errorf("could not parse byte size: value exceeds the maximum of %d: %w", uint64(math.MaxUint64), strconv.ErrRange)
+	}
+	return bytes.Uint64(), nil
+}
+
+func parsePercent(v string) (float64, error) {
+	v = strings.TrimSpace(v)
+	num, percent := strings.CutSuffix(v, "%")
+
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(num), 64)
+	if err != nil {
+		return 0, fmt.Errorf("could not parse percentage: %w", err)
+	}
+	if percent {
+		parsed /= 100
+	}
+
+	if !(parsed >= 0 && parsed <= 1) {
+		return 0, fmt.Errorf("could not parse percentage: %q is not between 0%% and 100%%", v)
+	}
+	return parsed, nil
+}
+
+func parseBase64(v string, enc *base64.Encoding) ([]byte, error) {
+	parsed, err := enc.DecodeString(v)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse base64: %w", err)
+	}
+	return parsed, nil
+}
+
+func parseHex(v string) ([]byte, error) {
+	parsed, err := hex.DecodeString(v)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse hex: %w", err)
+	}
+	return parsed, nil
+}
+
+func parseURL(v string, schemes []string) (*url.URL, error) {
+	parsed, err := url.Parse(v)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse URL: %w", err)
+	}
+	if !parsed.IsAbs() {
+		return nil, fmt.Errorf("could not parse URL: missing scheme in %q", v)
+	}
+	// Opaque URLs are most likely a host:port given where a URL is expected,
+	// e.g. localhost:8080 is parsed with the localhost scheme.
+	if parsed.Opaque != "" {
+		return nil, fmt.Errorf("could not parse URL: missing // after the scheme in %q", v)
+	}
+	if len(schemes) > 0 && !slices.ContainsFunc(schemes, func(s string) bool { return strings.EqualFold(s, parsed.Scheme) }) {
+		return nil, fmt.Errorf("could not parse URL: scheme %q is not one of %s", parsed.Scheme, strings.Join(schemes, ", "))
+	}
+	return parsed, nil
+}
+
+func parseHostPort(v string) (HostPort, error) {
+	host, port, err := net.SplitHostPort(v)
+	if err != nil {
+		return HostPort{}, fmt.Errorf("could not parse host:port: %w", err)
+	}
+
+	parsed, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return HostPort{}, fmt.Errorf("could not parse host:port: invalid port %q, expected a number between 0 and %d", port, maxUint(16))
+	}
+	return HostPort{Host: host, Port: uint16(parsed)}, nil
+}
+
+func parseIP(v string) (netip.Addr, error) {
+	parsed, err := netip.ParseAddr(v)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("could not parse IP address: %w", err)
+	}
+	return parsed, nil
+}
+
+func parseIPNet(v string) (netip.Prefix, error) {
+	parsed, err := netip.ParsePrefix(v)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("could not parse IP network: %w", err)
+	}
+	return parsed, nil
+}
+
+func parseSlice(v string, t reflect.Type, elem parseFunc, o *options) (any, error) {
+	if v == "" {
+		return reflect.MakeSlice(t, 0, 0).Interface(), nil
+	}
+
+	parts := strings.Split(v, o.separator)
+
+	parsed := reflect.MakeSlice(t, 0, len(parts))
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" && o.skipEmpty {
+			continue
+		}
+
+		item, err := elem(part, o)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse element %d: %w", i, err)
+		}
+		parsed = reflect.Append(parsed, reflect.ValueOf(item))
+	}
+	return parsed.Interface(), nil
+}
+
+func parseMap(v string, t reflect.Type, key, elem parseFunc, o *options) (any, error) {
+	if v == "" {
+		return reflect.MakeMap(t).Interface(), nil
+	}
+
+	pairs := strings.Split(v, o.separator)
+
+	parsed := reflect.MakeMapWithSize(t, len(pairs))
+	for i, pair := range pairs {
+		pair = strings.TrimSpace(pair)
+		if pair == "" && o.skipEmpty {
+			continue
+		}
+
+		rawKey, rawVal, ok := strings.Cut(pair, o.kvSeparator)
+		if !ok {
+			return nil, fmt.Errorf("could not parse pair %d: missing %q separator", i, o.kvSeparator)
+		}
+
+		k, err := key(strings.TrimSpace(rawKey), o)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse key of pair %d: %w", i, err)
+		}
+
+		item, err := elem(strings.TrimSpace(rawVal), o)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse value of pair %d: %w", i, err)
+		}
+
+		kv := reflect.ValueOf(k)
+		if parsed.MapIndex(kv).IsValid() {
+			switch o.duplicates {
+			case DuplicateKeepFirst:
+				continue
+			case DuplicateError:
+				return nil, fmt.Errorf("could not parse pair %d: %w %v", i, ErrDuplicateKey, k)
+			}
+		}
+		parsed.SetMapIndex(kv, reflect.ValueOf(item))
+	}
+	return parsed.Interface(), nil
+}
@@ -0,0 +1,55 @@
+package env
+
+import (
+	"context"
+	"log/slog"
+)
+
+// FallbackHook is called whenever a fallback value is returned instead of the
+// value of the variable identified by the key, with the error that caused it.
+type FallbackHook func(key string, err error, fallback any)
+
+// OnFallback registers the hook called whenever a package-level function such as
+// GetOr or WithDefaultInt returns its fallback value. Passing nil removes the hook.
+func OnFallback(hook FallbackHook) {
+	std.OnFallback(hook)
+}
+
+// OnFallback registers the hook called whenever a function of the loader such as
+// GetOrFrom or WithDefaultInt returns its fallback value. Passing nil removes the hook.
+//
+// It is safe to call OnFallback while the loader is in use.
+func (l *Loader) OnFallback(hook FallbackHook) {
+	l.onFallback.Store(&hook)
+}
+
+// fallback notifies the fallback hook that the fallback value is returned for the
+// variable identified by the fully qualified key. Loaders created with WithPrefix
+// notify the hook of their parent unless they have their own.
+func (l *Loader) fallback(key string, err error, fallback any) {
+	if hook := l.onFallback.Load(); hook != nil && *hook != nil {
+		(*hook)(key, err, fallback)
+		return
+	}
+	if l.parent != nil {
+		l.parent.fallback(key, err, fallback)
+	}
+}
+
+// SlogFallback returns a FallbackHook logging every fallback with the given logger
+// at the given level. If logger is nil, slog.Default() is used.
+//
+//	env.OnFallback(env.SlogFallback(logger, slog.LevelWarn))
+func SlogFallback(logger *slog.Logger, level slog.Level) FallbackHook {
+	return func(key string, err error, fallback any) {
+		l := logger
+		if l == nil {
+			l = slog.Default()
+		}
+		l.LogAttrs(context.Background(), level, "environment variable fallback applied",
+			slog.String("key", key),
+			slog.Any("error", err),
+			slog.Any("fallback", fallback),
+		)
+	}
+}
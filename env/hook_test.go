@@ -0,0 +1,74 @@
+package env
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"strconv"
+	"testing"
+)
+
+type fallbackCall struct {
+	key      string
+	err      error
+	fallback any
+}
+
+func TestOnFallback(t *testing.T) {
+	var calls []fallbackCall
+	OnFallback(func(key string, err error, fallback any) {
+		calls = append(calls, fallbackCall{key: key, err: err, fallback: fallback})
+	})
+	t.Cleanup(func() { OnFallback(nil) })
+
+	t.Setenv("PORT", "80a0")
+	t.Setenv("HOST", "localhost")
+
+	assert.Equal(t, 8080, WithDefaultInt("PORT", 8080))
+	assert.Equal(t, "localhost", WithDefaultString("HOST", "127.0.0.1"))
+	assert.Equal(t, true, WithDefaultBool("DEBUG", true))
+
+	assert.Len(t, calls, 2)
+	assert.Equal(t, "PORT", calls[0].key)
+	assert.ErrorIs(t, calls[0].err, strconv.ErrSyntax)
+	assert.Equal(t, 8080, calls[0].fallback)
+	assert.Equal(t, "DEBUG", calls[1].key)
+	assert.ErrorIs(t, calls[1].err, ErrUndefinedVariable)
+	assert.Equal(t, true, calls[1].fallback)
+}
+
+func TestLoader_OnFallback(t *testing.T) {
+	l := NewLoader(Map{"PORT": "80a0"})
+
+	var calls []fallbackCall
+	l.OnFallback(func(key string, err error, fallback any) {
+		calls = append(calls, fallbackCall{key: key, err: err, fallback: fallback})
+	})
+
+	_, err := GetOrStrictFrom(l, "PORT", 8080)
+	assert.Error(t, err)
+	assert.Empty(t, calls)
+
+	port, err := GetOrStrictFrom(l, "UNSET", 8080)
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, port)
+	assert.Len(t, calls, 1)
+
+	l.OnFallback(nil)
+	l.WithDefaultInt("UNSET", 8080)
+	assert.Len(t, calls, 1)
+}
+
+func TestSlogFallback(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	l := NewLoader(Map{})
+	l.OnFallback(SlogFallback(logger, slog.LevelWarn))
+	l.WithDefaultDuration("TIMEOUT", 0)
+
+	assert.Contains(t, buf.String(), "level=WARN")
+	assert.Contains(t, buf.String(), `msg="environment variable fallback applied"`)
+	assert.Contains(t, buf.String(), "key=TIMEOUT")
+	assert.Contains(t, buf.String(), "fallback=0s")
+}
@@ -0,0 +1,89 @@
+package env
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// WithJSON retrieves the value of an environment variable identified by the key
+// and decodes it as JSON into the value pointed to by dst, e.g. a struct or a map.
+// Fields of the value that do not exist in dst are rejected, unless the
+// AllowUnknownFields option is given. dst is left untouched if the variable
+// cannot be retrieved or decoded.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithJSON(key string, dst any, opts ...Option) error {
+	return std.WithJSON(key, dst, opts...)
+}
+
+// WithDefaultJSON retrieves the value of an environment variable identified by the key
+// and decodes it as JSON into the value pointed to by dst. If the environment variable
+// is not set, empty or cannot be decoded, the current value of dst is kept as the fallback.
+//
+// This method is a convenience wrapper around WithJSON to silent the error and return a fallback value.
+func WithDefaultJSON(key string, dst any, opts ...Option) {
+	std.WithDefaultJSON(key, dst, opts...)
+}
+
+// WithJSON retrieves the value of the variable identified by the key from the loader's source
+// and decodes it as JSON into the value pointed to by dst, the same way the package-level WithJSON does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithJSON(key string, dst any, opts ...Option) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return &VarError{Key: l.key(key), Type: fmt.Sprintf("%T", dst), Err: &json.InvalidUnmarshalError{Type: reflect.TypeOf(dst)}}
+	}
+
+	t := rv.Type()
+	o := l.options(withParser(opts, func(v string, o *options) (any, error) {
+		return decodeJSON(v, t.Elem(), o.allowUnknownFields)
+	}))
+
+	parsed, err := l.get(key, t, &o)
+	if err != nil {
+		return err
+	}
+
+	rv.Elem().Set(reflect.ValueOf(parsed).Elem())
+	return nil
+}
+
+// WithDefaultJSON retrieves the value of the variable identified by the key from the loader's source
+// and decodes it as JSON into the value pointed to by dst. If the variable is not set, empty or
+// cannot be decoded, the current value of dst is kept as the fallback.
+//
+// This method is a convenience wrapper around WithJSON to silent the error and return a fallback value.
+func (l *Loader) WithDefaultJSON(key string, dst any, opts ...Option) {
+	if err := l.WithJSON(key, dst, opts...); err != nil {
+		fallback := dst
+		if rv := reflect.ValueOf(dst); rv.Kind() == reflect.Pointer && !rv.IsNil() {
+			fallback = rv.Elem().Interface()
+		}
+		l.fallback(l.key(key), err, fallback)
+	}
+}
+
+// decodeJSON decodes the JSON value v into a new value of the type t, and returns
+// a pointer to it. The value must be a single JSON document.
+func decodeJSON(v string, t reflect.Type, allowUnknownFields bool) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(v))
+	if !allowUnknownFields {
+		dec.DisallowUnknownFields()
+	}
+
+	dst := reflect.New(t)
+	if err := dec.Decode(dst.Interface()); err != nil {
+		return nil, fmt.Errorf("could not decode JSON: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("could not decode JSON: unexpected data after the value at offset %d", dec.InputOffset())
+	}
+	return dst.Interface(), nil
+}
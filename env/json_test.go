@@ -0,0 +1,106 @@
+package env
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"testing"
+)
+
+type jsonTestRoute struct {
+	Path    string `json:"path"`
+	Backend string `json:"backend"`
+}
+
+func TestWithJSON(t *testing.T) {
+	cases := []testutils.TestCase[[]jsonTestRoute]{
+		{
+			Name:     "correctly decodes the value",
+			Env:      map[string]string{"ROUTES": `[{"path": "/api", "backend": "api:8080"}]`},
+			Expected: []jsonTestRoute{{Path: "/api", Backend: "api:8080"}},
+		},
+		{
+			Name:       "returns an error on unknown fields",
+			Env:        map[string]string{"ROUTES": `[{"path": "/api", "timeout": 30}]`},
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error on trailing data",
+			Env:        map[string]string{"ROUTES": `[] []`},
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns an error on invalid JSON",
+			Env:        map[string]string{"ROUTES": `[{"path": }]`},
+			ShouldFail: true,
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			var received []jsonTestRoute
+			err := WithJSON("ROUTES", &received)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				assert.Nil(t, received)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithJSON_Options(t *testing.T) {
+	l := NewLoader(Map{
+		"FLAGS": `{"beta": true, "legacy": false}`,
+		"ROUTE": `{"path": "/api", "timeout": 30}`,
+		"TOKEN": `{"secret": "s3cr3t"`,
+	})
+
+	var flags map[string]bool
+	assert.NoError(t, l.WithJSON("FLAGS", &flags))
+	assert.Equal(t, map[string]bool{"beta": true, "legacy": false}, flags)
+
+	var route jsonTestRoute
+	err := l.WithJSON("ROUTE", &route)
+	assert.ErrorContains(t, err, `ROUTE: could not decode JSON: json: unknown field "timeout"`)
+
+	assert.NoError(t, l.WithJSON("ROUTE", &route, AllowUnknownFields()))
+	assert.Equal(t, jsonTestRoute{Path: "/api"}, route)
+
+	var token map[string]string
+	err = l.WithJSON("TOKEN", &token, Sensitive())
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "s3cr3t")
+
+	var invalid *json.InvalidUnmarshalError
+	assert.ErrorAs(t, l.WithJSON("FLAGS", flags), &invalid)
+	assert.ErrorAs(t, l.WithJSON("FLAGS", nil), &invalid)
+}
+
+func TestWithDefaultJSON(t *testing.T) {
+	t.Setenv("ROUTE", `{"path": "/api", "backend": "api:8080"}`)
+	t.Setenv("INVALID", `{"path": 1}`)
+
+	route := jsonTestRoute{Path: "/", Backend: "web:80"}
+	WithDefaultJSON("UNSET", &route)
+	assert.Equal(t, jsonTestRoute{Path: "/", Backend: "web:80"}, route)
+
+	WithDefaultJSON("INVALID", &route)
+	assert.Equal(t, jsonTestRoute{Path: "/", Backend: "web:80"}, route)
+
+	WithDefaultJSON("ROUTE", &route)
+	assert.Equal(t, jsonTestRoute{Path: "/api", Backend: "api:8080"}, route)
+}
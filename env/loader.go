@@ -0,0 +1,517 @@
+package env
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync/atomic"
+	"time"
+)
+
+// Loader retrieves and parses environment variables from a Source.
+//
+// The package-level functions use a Loader backed by the process environment,
+// a dedicated Loader is useful to read variables from a map in tests, from a
+// dotenv file or from a secrets store.
+type Loader struct {
+	source     Source
+	opts       options
+	prefix     string
+	parent     *Loader
+	onFallback atomic.Pointer[FallbackHook]
+}
+
+// std is the Loader used by the package-level functions.
+var std = NewLoader(OS())
+
+// NewLoader returns a Loader retrieving environment variables from the given source.
+// The options apply to every lookup made through the loader.
+func NewLoader(source Source, opts ...Option) *Loader {
+	l := &Loader{source: source, opts: defaultOptions()}
+	for _, opt := range opts {
+		opt(&l.opts)
+	}
+	return l
+}
+
+// WithPrefix returns a Loader reading the variables of the process environment
+// whose name starts with the given prefix, e.g.
+//
+//	billing := env.WithPrefix("BILLING_")
+//	host, err := billing.WithString("DB_HOST") // reads BILLING_DB_HOST
+//
+// Errors and fallback hooks report the fully qualified key.
+func WithPrefix(prefix string) *Loader {
+	return std.WithPrefix(prefix)
+}
+
+// WithPrefix returns a Loader reading the variables of the loader's source whose
+// name starts with the given prefix, appended to the prefix of the loader if any.
+// The returned loader shares the source and the options of the loader, and
+// notifies its fallback hook unless it registers its own.
+func (l *Loader) WithPrefix(prefix string) *Loader {
+	return &Loader{
+		source: l.source,
+		opts:   l.opts,
+		prefix: l.prefix + prefix,
+		parent: l,
+	}
+}
+
+// GetFrom retrieves the value of the variable identified by the key from the
+// loader's source and parses it into T, the same way Get does. Errors are
+// returned as a *VarError.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+// ErrUnsupportedType is returned if no parser is registered for T.
+func GetFrom[T any](l *Loader, key string, opts ...Option) (T, error) {
+	o := l.options(opts)
+
+	parsed, err := l.get(key, typeOf[T](), &o)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return parsed.(T), nil
+}
+
+// GetOrFrom retrieves the value of the variable identified by the key from the
+// loader's source and parses it into T. If the variable is not set, empty or
+// cannot be parsed, it returns the fallback value provided. With the AllowEmpty
+// option, an empty variable is parsed instead, so only an unset variable or a
+// parsing failure returns the fallback value.
+//
+// This method is a convenience wrapper around GetFrom to silent the error and return a fallback value.
+func GetOrFrom[T any](l *Loader, key string, fallback T, opts ...Option) T {
+	val, err := GetFrom[T](l, key, opts...)
+	if err != nil {
+		l.fallback(l.key(key), err, fallback)
+		return fallback
+	}
+	return val
+}
+
+// GetOrStrictFrom retrieves the value of the variable identified by the key from the
+// loader's source and parses it into T. If the variable is not set or empty, it returns
+// the fallback value provided. Unlike GetOrFrom, any other error, such as a parsing
+// failure, is returned instead of being silently replaced by the fallback value.
+func GetOrStrictFrom[T any](l *Loader, key string, fallback T, opts ...Option) (T, error) {
+	val, err := GetFrom[T](l, key, opts...)
+	if errors.Is(err, ErrUndefinedVariable) || errors.Is(err, ErrEmptyVariable) {
+		l.fallback(l.key(key), err, fallback)
+		return fallback, nil
+	}
+	return val, err
+}
+
+// WithString retrieves the value of the variable identified by the key from the loader's source.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithString(key string, opts ...Option) (string, error) {
+	return GetFrom[string](l, key, opts...)
+}
+
+// WithBool retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a boolean. An error is returned if the boolean parsing fails.
+// Common values such as yes, on or enabled are accepted case-insensitively, see the
+// BoolValues and StrictBool options to change the accepted values.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithBool(key string, opts ...Option) (bool, error) {
+	return GetFrom[bool](l, key, opts...)
+}
+
+// WithInt retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an integer. An error is returned if the integer parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt(key string, opts ...Option) (int, error) {
+	return orInvalid(GetFrom[int](l, key, opts...))
+}
+
+// WithInt8 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an integer. An error is returned if the integer parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt8(key string, opts ...Option) (int8, error) {
+	return orInvalid(GetFrom[int8](l, key, opts...))
+}
+
+// WithInt16 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an integer. An error is returned if the integer parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt16(key string, opts ...Option) (int16, error) {
+	return orInvalid(GetFrom[int16](l, key, opts...))
+}
+
+// WithInt32 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an integer. An error is returned if the integer parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt32(key string, opts ...Option) (int32, error) {
+	return orInvalid(GetFrom[int32](l, key, opts...))
+}
+
+// WithInt64 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an integer. An error is returned if the integer parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt64(key string, opts ...Option) (int64, error) {
+	return orInvalid(GetFrom[int64](l, key, opts...))
+}
+
+// WithUint retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint(key string, opts ...Option) (uint, error) {
+	return GetFrom[uint](l, key, opts...)
+}
+
+// WithUint8 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint8.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint8(key string, opts ...Option) (uint8, error) {
+	return GetFrom[uint8](l, key, opts...)
+}
+
+// WithUint16 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint16.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint16(key string, opts ...Option) (uint16, error) {
+	return GetFrom[uint16](l, key, opts...)
+}
+
+// WithUint32 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint32.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint32(key string, opts ...Option) (uint32, error) {
+	return GetFrom[uint32](l, key, opts...)
+}
+
+// WithUint64 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uint64.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint64(key string, opts ...Option) (uint64, error) {
+	return GetFrom[uint64](l, key, opts...)
+}
+
+// WithUintptr retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as an unsigned integer. An error is returned if the unsigned integer parsing fails,
+// including when the value is negative or overflows uintptr.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUintptr(key string, opts ...Option) (uintptr, error) {
+	return GetFrom[uintptr](l, key, opts...)
+}
+
+// WithFloat32 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a float32. An error is returned if the float32 parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithFloat32(key string, opts ...Option) (float32, error) {
+	return orInvalid(GetFrom[float32](l, key, opts...))
+}
+
+// WithFloat64 retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a float64. An error is returned if the float64 parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithFloat64(key string, opts ...Option) (float64, error) {
+	return orInvalid(GetFrom[float64](l, key, opts...))
+}
+
+// WithDuration retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a time.Duration. An error is returned if the duration parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithDuration(key string, opts ...Option) (time.Duration, error) {
+	return GetFrom[time.Duration](l, key, opts...)
+}
+
+// WithTime retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a time.Time, using the layout set with the Layout option or time.RFC3339.
+// An error is returned if the time parsing fails.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithTime(key string, opts ...Option) (time.Time, error) {
+	return GetFrom[time.Time](l, key, opts...)
+}
+
+// WithDefaultString retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default string provided.
+//
+// This method is a convenience wrapper around WithString to silent the error and return a fallback value.
+func (l *Loader) WithDefaultString(key string, fallback string, opts ...Option) string {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultBool retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default bool provided.
+// Values are parsed the same way WithBool does, with the same vocabulary.
+//
+// This method is a convenience wrapper around WithBool to silent the error and return a fallback value.
+func (l *Loader) WithDefaultBool(key string, fallback bool, opts ...Option) bool {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default int provided.
+//
+// This method is a convenience wrapper around WithInt to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt(key string, fallback int, opts ...Option) int {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt8 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default int8 provided.
+//
+// This method is a convenience wrapper around WithInt8 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt8(key string, fallback int8, opts ...Option) int8 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt16 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default int16 provided.
+//
+// This method is a convenience wrapper around WithInt16 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt16(key string, fallback int16, opts ...Option) int16 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt32 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default int32 provided.
+//
+// This method is a convenience wrapper around WithInt32 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt32(key string, fallback int32, opts ...Option) int32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt64 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default int64 provided.
+//
+// This method is a convenience wrapper around WithInt64 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt64(key string, fallback int64, opts ...Option) int64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default uint provided.
+//
+// This method is a convenience wrapper around WithUint to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint(key string, fallback uint, opts ...Option) uint {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint8 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default uint8 provided.
+//
+// This method is a convenience wrapper around WithUint8 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint8(key string, fallback uint8, opts ...Option) uint8 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint16 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default uint16 provided.
+//
+// This method is a convenience wrapper around WithUint16 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint16(key string, fallback uint16, opts ...Option) uint16 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint32 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default uint32 provided.
+//
+// This method is a convenience wrapper around WithUint32 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint32(key string, fallback uint32, opts ...Option) uint32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint64 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default uint64 provided.
+//
+// This method is a convenience wrapper around WithUint64 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint64(key string, fallback uint64, opts ...Option) uint64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUintptr retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default uintptr provided.
+//
+// This method is a convenience wrapper around WithUintptr to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUintptr(key string, fallback uintptr, opts ...Option) uintptr {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultFloat32 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default float32 provided.
+//
+// This method is a convenience wrapper around WithFloat32 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultFloat32(key string, fallback float32, opts ...Option) float32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultFloat64 retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default float64 provided.
+//
+// This method is a convenience wrapper around WithFloat64 to silent the error and return a fallback value.
+func (l *Loader) WithDefaultFloat64(key string, fallback float64, opts ...Option) float64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultDuration retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default time.Duration provided.
+//
+// This method is a convenience wrapper around WithDuration to silent the error and return a fallback value.
+func (l *Loader) WithDefaultDuration(key string, fallback time.Duration, opts ...Option) time.Duration {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultTime retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default time.Time provided.
+//
+// This method is a convenience wrapper around WithTime to silent the error and return a fallback value.
+func (l *Loader) WithDefaultTime(key string, fallback time.Time, opts ...Option) time.Time {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// options returns the loader options with the given ones applied on top of them.
+func (l *Loader) options(opts []Option) options {
+	o := l.opts
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// get retrieves the value of the variable identified by the key, qualified with
+// the prefix of the loader, and parses it into a value of the type t, with the
+// parser set in the options if any. Every error is returned as a *VarError.
+func (l *Loader) get(key string, t reflect.Type, o *options) (any, error) {
+	key = l.key(key)
+
+	p := o.parse
+	if p == nil {
+		var err error
+		if p, err = parserFor(t); err != nil {
+			return nil, l.error(key, "", t, o, err)
+		}
+	}
+
+	val, err := l.lookup(key, o)
+	if err != nil {
+		return nil, l.error(key, "", t, o, err)
+	}
+
+	parsed, err := p(val, o)
+	if err != nil {
+		return nil, l.error(key, val, t, o, err)
+	}
+
+	// Values decoded by the parser of the options, such as the bytes of WithBase64,
+	// are validated as a whole rather than element by element.
+	if o.parse != nil {
+		err = validateValue(parsed, o.validators)
+	} else {
+		err = validate(parsed, o.validators, o.collectionValidators)
+	}
+	if err != nil {
+		return nil, l.error(key, val, t, o, err)
+	}
+	return parsed, nil
+}
+
+// error returns the *VarError describing the failure to retrieve a variable,
+// redacted if the variable is sensitive.
+func (l *Loader) error(key, val string, t reflect.Type, o *options, err error) *VarError {
+	varErr := &VarError{Key: key, Value: val, Type: t.String(), Err: err}
+	if o.sensitive || isSensitive(t) {
+		varErr.Redact()
+	}
+	return varErr
+}
+
+// key returns the fully qualified name of the variable identified by the key.
+func (l *Loader) key(key string) string {
+	return l.prefix + key
+}
+
+// lookup is a helper function to check the content of a variable of the loader's source.
+func (l *Loader) lookup(key string, o *options) (string, error) {
+	val, ok := l.source.Lookup(key)
+
+	if o.fileSuffix != "" {
+		if path, fromFile := l.source.Lookup(key + o.fileSuffix); fromFile {
+			if ok {
+				return "", fmt.Errorf("%w: %s and %s", ErrConflictingVariables, key, key+o.fileSuffix)
+			}
+			return l.lookupFile(path, o)
+		}
+	}
+
+	if !ok {
+		return "", ErrUndefinedVariable
+	}
+
+	if o.expand {
+		e := &expander{source: l.source, stack: []string{key}}
+
+		expanded, err := e.expand(val)
+		if err != nil {
+			return "", fmt.Errorf("could not expand value: %w", err)
+		}
+		val = expanded
+	}
+
+	if val == "" && !o.allowEmpty {
+		return "", ErrEmptyVariable
+	}
+
+	return val, nil
+}
+
+// lookupFile is a helper function to check the content of the file a variable refers to.
+func (l *Loader) lookupFile(path string, o *options) (string, error) {
+	if path == "" {
+		return "", ErrEmptyVariable
+	}
+
+	val, err := readFile(path, o.maxFileSize)
+	if err != nil {
+		return "", err
+	}
+
+	if val == "" && !o.allowEmpty {
+		return "", ErrEmptyVariable
+	}
+
+	return val, nil
+}
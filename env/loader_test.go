@@ -0,0 +1,240 @@
+package env
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"testing"
+	"time"
+)
+
+func TestGetFrom(t *testing.T) {
+	cases := []testutils.TestCase[int]{
+		{
+			Name:     "correctly retrieves the value from the source",
+			Given:    "FOO",
+			Expected: 10,
+			Env: map[string]string{
+				"FOO": "10",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the variable is not in the source",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns ErrEmptyVariable if the variable is empty",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrEmptyVariable,
+			Env: map[string]string{
+				"FOO": "",
+			},
+		},
+		{
+			Name:       "returns an error if the value is not an integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "notaninteger",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			l := NewLoader(Map(tc.Env))
+
+			received, err := GetFrom[int](l, tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestGetOrFrom(t *testing.T) {
+	cases := []testutils.TestCase[float64]{
+		{
+			Name:     "correctly retrieves the value from the source",
+			Given:    "FOO",
+			Expected: 0.1,
+			Env: map[string]string{
+				"FOO": "0.1",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the variable is not in the source",
+			Given:    "FOO",
+			Expected: 2.5,
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: 2.5,
+			Env: map[string]string{
+				"FOO": "notafloat",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			l := NewLoader(Map(tc.Env))
+			assert.Equal(t, tc.Expected, GetOrFrom(l, tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestGetOrStrictFrom(t *testing.T) {
+	l := NewLoader(Map{"PORT": "80a0", "EMPTY": ""})
+
+	port, err := GetOrStrictFrom(l, "UNSET", 8080)
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, port)
+
+	_, err = GetOrStrictFrom(l, "PORT", 8080)
+	assert.Error(t, err)
+
+	_, err = GetOrStrictFrom(l, "EMPTY", 8080, AllowEmpty())
+	assert.Error(t, err)
+}
+
+func TestLoader_DoesNotReadTheProcessEnvironment(t *testing.T) {
+	t.Setenv("FOO", "bar")
+
+	l := NewLoader(Map{})
+
+	_, err := l.WithString("FOO")
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+	assert.Equal(t, "fallback", l.WithDefaultString("FOO", "fallback"))
+}
+
+func TestLoader_Methods(t *testing.T) {
+	l := NewLoader(Map{
+		"STRING": "hello",
+		"BOOL":   "true",
+		"INT":    "-1",
+		"FLOAT":  "1.5",
+	})
+
+	str, err := l.WithString("STRING")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", str)
+
+	b, err := l.WithBool("BOOL")
+	assert.NoError(t, err)
+	assert.True(t, b)
+
+	i8, err := l.WithInt8("INT")
+	assert.NoError(t, err)
+	assert.Equal(t, int8(-1), i8)
+
+	f32, err := l.WithFloat32("FLOAT")
+	assert.NoError(t, err)
+	assert.Equal(t, float32(1.5), f32)
+
+	assert.Equal(t, int64(42), l.WithDefaultInt64("UNSET", 42))
+}
+
+func TestLoader_Options(t *testing.T) {
+	l := NewLoader(Map{"DATE": "01/05/2024"}, Layout("02/01/2006"))
+
+	received, err := l.WithTime("DATE")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), received)
+
+	_, err = l.WithTime("DATE", Layout(time.DateOnly))
+	assert.Error(t, err)
+}
+
+func TestLoader_AllowEmpty(t *testing.T) {
+	l := NewLoader(Map{"PREFIX": "", "HOSTS": ""}, AllowEmpty())
+
+	prefix, err := l.WithString("PREFIX")
+	assert.NoError(t, err)
+	assert.Empty(t, prefix)
+
+	hosts, err := l.WithStringSlice("HOSTS")
+	assert.NoError(t, err)
+	assert.Empty(t, hosts)
+
+	assert.Equal(t, map[string]int{}, l.WithDefaultIntMap("HOSTS", nil))
+}
+
+func TestWithPrefix(t *testing.T) {
+	t.Setenv("BILLING_DB_HOST", "billing.db")
+	t.Setenv("SEARCH_DB_HOST", "search.db")
+
+	billing, err := WithPrefix("BILLING_").WithString("DB_HOST")
+	assert.NoError(t, err)
+	assert.Equal(t, "billing.db", billing)
+
+	search, err := WithPrefix("SEARCH_").WithString("DB_HOST")
+	assert.NoError(t, err)
+	assert.Equal(t, "search.db", search)
+}
+
+func TestLoader_WithPrefix(t *testing.T) {
+	l := NewLoader(Map{
+		"APP_DB_PORT": "5432",
+		"APP_DB_HOST": "",
+	}, AllowEmpty())
+	db := l.WithPrefix("APP_").WithPrefix("DB_")
+
+	port, err := db.WithInt("PORT")
+	assert.NoError(t, err)
+	assert.Equal(t, 5432, port)
+
+	host, err := db.WithString("HOST")
+	assert.NoError(t, err)
+	assert.Empty(t, host)
+
+	_, err = db.WithString("USER")
+	var varErr *VarError
+	assert.ErrorAs(t, err, &varErr)
+	assert.Equal(t, "APP_DB_USER", varErr.Key)
+
+	c := db.NewCollector()
+	c.Add("NAME", errors.New("boom"))
+	assert.ErrorContains(t, c.Err(), "APP_DB_NAME: boom")
+}
+
+func TestLoader_WithPrefix_FallbackHook(t *testing.T) {
+	l := NewLoader(Map{})
+
+	var keys []string
+	l.OnFallback(func(key string, _ error, _ any) {
+		keys = append(keys, key)
+	})
+
+	db := l.WithPrefix("DB_")
+	db.WithDefaultInt("PORT", 5432)
+
+	assert.Equal(t, []string{"DB_PORT"}, keys)
+}
+
+func TestLoader_Bind(t *testing.T) {
+	l := NewLoader(Map{
+		"HOST": "localhost",
+		"PORT": "8080",
+	})
+
+	var cfg struct {
+		Host string `env:"HOST"`
+		Port int    `env:"PORT"`
+	}
+
+	assert.NoError(t, l.Bind(&cfg))
+	assert.Equal(t, "localhost", cfg.Host)
+	assert.Equal(t, 8080, cfg.Port)
+}
@@ -0,0 +1,750 @@
+package env
+
+import "time"
+
+// WithStringMap retrieves the value of an environment variable identified by the key
+// and splits it into a map of string to string, e.g. "team=core,env=prod". Pairs are separated
+// by a comma and keys from values by an equal sign, unless other separators are set with the
+// Separator and KeyValueSeparator options. Keys declared several times are handled according
+// to the DuplicateKeys option.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithStringMap(key string, opts ...Option) (map[string]string, error) {
+	return Get[map[string]string](key, opts...)
+}
+
+// WithBoolMap retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to bool. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithBoolMap(key string, opts ...Option) (map[string]bool, error) {
+	return Get[map[string]bool](key, opts...)
+}
+
+// WithIntMap retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to int. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithIntMap(key string, opts ...Option) (map[string]int, error) {
+	return Get[map[string]int](key, opts...)
+}
+
+// WithInt8Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to int8. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithInt8Map(key string, opts ...Option) (map[string]int8, error) {
+	return Get[map[string]int8](key, opts...)
+}
+
+// WithInt16Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to int16. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithInt16Map(key string, opts ...Option) (map[string]int16, error) {
+	return Get[map[string]int16](key, opts...)
+}
+
+// WithInt32Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to int32. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithInt32Map(key string, opts ...Option) (map[string]int32, error) {
+	return Get[map[string]int32](key, opts...)
+}
+
+// WithInt64Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to int64. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithInt64Map(key string, opts ...Option) (map[string]int64, error) {
+	return Get[map[string]int64](key, opts...)
+}
+
+// WithUintMap retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to uint. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUintMap(key string, opts ...Option) (map[string]uint, error) {
+	return Get[map[string]uint](key, opts...)
+}
+
+// WithUint8Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to uint8. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint8Map(key string, opts ...Option) (map[string]uint8, error) {
+	return Get[map[string]uint8](key, opts...)
+}
+
+// WithUint16Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to uint16. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint16Map(key string, opts ...Option) (map[string]uint16, error) {
+	return Get[map[string]uint16](key, opts...)
+}
+
+// WithUint32Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to uint32. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint32Map(key string, opts ...Option) (map[string]uint32, error) {
+	return Get[map[string]uint32](key, opts...)
+}
+
+// WithUint64Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to uint64. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint64Map(key string, opts ...Option) (map[string]uint64, error) {
+	return Get[map[string]uint64](key, opts...)
+}
+
+// WithUintptrMap retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to uintptr. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUintptrMap(key string, opts ...Option) (map[string]uintptr, error) {
+	return Get[map[string]uintptr](key, opts...)
+}
+
+// WithFloat32Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to float32. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithFloat32Map(key string, opts ...Option) (map[string]float32, error) {
+	return Get[map[string]float32](key, opts...)
+}
+
+// WithFloat64Map retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to float64. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithFloat64Map(key string, opts ...Option) (map[string]float64, error) {
+	return Get[map[string]float64](key, opts...)
+}
+
+// WithDurationMap retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to time.Duration. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithDurationMap(key string, opts ...Option) (map[string]time.Duration, error) {
+	return Get[map[string]time.Duration](key, opts...)
+}
+
+// WithTimeMap retrieves the value of an environment variable identified by the key
+// and tries to parse it as a map of string to time.Time. Pairs are separated by a comma and keys
+// from values by an equal sign, unless other separators are set with the Separator and
+// KeyValueSeparator options. Keys declared several times are handled according to the
+// DuplicateKeys option. An error reporting the index of the failing pair is returned if
+// the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithTimeMap(key string, opts ...Option) (map[string]time.Time, error) {
+	return Get[map[string]time.Time](key, opts...)
+}
+
+// WithDefaultStringMap retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]string provided.
+//
+// This method is a convenience wrapper around WithStringMap to silent the error and return a fallback value.
+func WithDefaultStringMap(key string, fallback map[string]string, opts ...Option) map[string]string {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultBoolMap retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]bool provided.
+//
+// This method is a convenience wrapper around WithBoolMap to silent the error and return a fallback value.
+func WithDefaultBoolMap(key string, fallback map[string]bool, opts ...Option) map[string]bool {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultIntMap retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]int provided.
+//
+// This method is a convenience wrapper around WithIntMap to silent the error and return a fallback value.
+func WithDefaultIntMap(key string, fallback map[string]int, opts ...Option) map[string]int {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultInt8Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]int8 provided.
+//
+// This method is a convenience wrapper around WithInt8Map to silent the error and return a fallback value.
+func WithDefaultInt8Map(key string, fallback map[string]int8, opts ...Option) map[string]int8 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultInt16Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]int16 provided.
+//
+// This method is a convenience wrapper around WithInt16Map to silent the error and return a fallback value.
+func WithDefaultInt16Map(key string, fallback map[string]int16, opts ...Option) map[string]int16 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultInt32Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]int32 provided.
+//
+// This method is a convenience wrapper around WithInt32Map to silent the error and return a fallback value.
+func WithDefaultInt32Map(key string, fallback map[string]int32, opts ...Option) map[string]int32 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultInt64Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]int64 provided.
+//
+// This method is a convenience wrapper around WithInt64Map to silent the error and return a fallback value.
+func WithDefaultInt64Map(key string, fallback map[string]int64, opts ...Option) map[string]int64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUintMap retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]uint provided.
+//
+// This method is a convenience wrapper around WithUintMap to silent the error and return a fallback value.
+func WithDefaultUintMap(key string, fallback map[string]uint, opts ...Option) map[string]uint {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint8Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]uint8 provided.
+//
+// This method is a convenience wrapper around WithUint8Map to silent the error and return a fallback value.
+func WithDefaultUint8Map(key string, fallback map[string]uint8, opts ...Option) map[string]uint8 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint16Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]uint16 provided.
+//
+// This method is a convenience wrapper around WithUint16Map to silent the error and return a fallback value.
+func WithDefaultUint16Map(key string, fallback map[string]uint16, opts ...Option) map[string]uint16 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint32Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]uint32 provided.
+//
+// This method is a convenience wrapper around WithUint32Map to silent the error and return a fallback value.
+func WithDefaultUint32Map(key string, fallback map[string]uint32, opts ...Option) map[string]uint32 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint64Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]uint64 provided.
+//
+// This method is a convenience wrapper around WithUint64Map to silent the error and return a fallback value.
+func WithDefaultUint64Map(key string, fallback map[string]uint64, opts ...Option) map[string]uint64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUintptrMap retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]uintptr provided.
+//
+// This method is a convenience wrapper around WithUintptrMap to silent the error and return a fallback value.
+func WithDefaultUintptrMap(key string, fallback map[string]uintptr, opts ...Option) map[string]uintptr {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultFloat32Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]float32 provided.
+//
+// This method is a convenience wrapper around WithFloat32Map to silent the error and return a fallback value.
+func WithDefaultFloat32Map(key string, fallback map[string]float32, opts ...Option) map[string]float32 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultFloat64Map retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]float64 provided.
+//
+// This method is a convenience wrapper around WithFloat64Map to silent the error and return a fallback value.
+func WithDefaultFloat64Map(key string, fallback map[string]float64, opts ...Option) map[string]float64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultDurationMap retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]time.Duration provided.
+//
+// This method is a convenience wrapper around WithDurationMap to silent the error and return a fallback value.
+func WithDefaultDurationMap(key string, fallback map[string]time.Duration, opts ...Option) map[string]time.Duration {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultTimeMap retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default map[string]time.Time provided.
+//
+// This method is a convenience wrapper around WithTimeMap to silent the error and return a fallback value.
+func WithDefaultTimeMap(key string, fallback map[string]time.Time, opts ...Option) map[string]time.Time {
+	return GetOr(key, fallback, opts...)
+}
+
+// MustStringMap is like WithStringMap but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustStringMap(key string, opts ...Option) map[string]string {
+	return Must(WithStringMap(key, opts...))
+}
+
+// MustBoolMap is like WithBoolMap but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustBoolMap(key string, opts ...Option) map[string]bool {
+	return Must(WithBoolMap(key, opts...))
+}
+
+// MustIntMap is like WithIntMap but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustIntMap(key string, opts ...Option) map[string]int {
+	return Must(WithIntMap(key, opts...))
+}
+
+// MustInt8Map is like WithInt8Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt8Map(key string, opts ...Option) map[string]int8 {
+	return Must(WithInt8Map(key, opts...))
+}
+
+// MustInt16Map is like WithInt16Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt16Map(key string, opts ...Option) map[string]int16 {
+	return Must(WithInt16Map(key, opts...))
+}
+
+// MustInt32Map is like WithInt32Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt32Map(key string, opts ...Option) map[string]int32 {
+	return Must(WithInt32Map(key, opts...))
+}
+
+// MustInt64Map is like WithInt64Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt64Map(key string, opts ...Option) map[string]int64 {
+	return Must(WithInt64Map(key, opts...))
+}
+
+// MustUintMap is like WithUintMap but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUintMap(key string, opts ...Option) map[string]uint {
+	return Must(WithUintMap(key, opts...))
+}
+
+// MustUint8Map is like WithUint8Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint8Map(key string, opts ...Option) map[string]uint8 {
+	return Must(WithUint8Map(key, opts...))
+}
+
+// MustUint16Map is like WithUint16Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint16Map(key string, opts ...Option) map[string]uint16 {
+	return Must(WithUint16Map(key, opts...))
+}
+
+// MustUint32Map is like WithUint32Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint32Map(key string, opts ...Option) map[string]uint32 {
+	return Must(WithUint32Map(key, opts...))
+}
+
+// MustUint64Map is like WithUint64Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint64Map(key string, opts ...Option) map[string]uint64 {
+	return Must(WithUint64Map(key, opts...))
+}
+
+// MustUintptrMap is like WithUintptrMap but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUintptrMap(key string, opts ...Option) map[string]uintptr {
+	return Must(WithUintptrMap(key, opts...))
+}
+
+// MustFloat32Map is like WithFloat32Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustFloat32Map(key string, opts ...Option) map[string]float32 {
+	return Must(WithFloat32Map(key, opts...))
+}
+
+// MustFloat64Map is like WithFloat64Map but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustFloat64Map(key string, opts ...Option) map[string]float64 {
+	return Must(WithFloat64Map(key, opts...))
+}
+
+// MustDurationMap is like WithDurationMap but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustDurationMap(key string, opts ...Option) map[string]time.Duration {
+	return Must(WithDurationMap(key, opts...))
+}
+
+// MustTimeMap is like WithTimeMap but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustTimeMap(key string, opts ...Option) map[string]time.Time {
+	return Must(WithTimeMap(key, opts...))
+}
+
+// WithStringMap retrieves the value of the variable identified by the key from the loader's source
+// and splits it into a map of string to string, the same way the package-level WithStringMap does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithStringMap(key string, opts ...Option) (map[string]string, error) {
+	return GetFrom[map[string]string](l, key, opts...)
+}
+
+// WithBoolMap retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to bool, the same way the package-level WithBoolMap does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithBoolMap(key string, opts ...Option) (map[string]bool, error) {
+	return GetFrom[map[string]bool](l, key, opts...)
+}
+
+// WithIntMap retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to int, the same way the package-level WithIntMap does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithIntMap(key string, opts ...Option) (map[string]int, error) {
+	return GetFrom[map[string]int](l, key, opts...)
+}
+
+// WithInt8Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to int8, the same way the package-level WithInt8Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt8Map(key string, opts ...Option) (map[string]int8, error) {
+	return GetFrom[map[string]int8](l, key, opts...)
+}
+
+// WithInt16Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to int16, the same way the package-level WithInt16Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt16Map(key string, opts ...Option) (map[string]int16, error) {
+	return GetFrom[map[string]int16](l, key, opts...)
+}
+
+// WithInt32Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to int32, the same way the package-level WithInt32Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt32Map(key string, opts ...Option) (map[string]int32, error) {
+	return GetFrom[map[string]int32](l, key, opts...)
+}
+
+// WithInt64Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to int64, the same way the package-level WithInt64Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt64Map(key string, opts ...Option) (map[string]int64, error) {
+	return GetFrom[map[string]int64](l, key, opts...)
+}
+
+// WithUintMap retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to uint, the same way the package-level WithUintMap does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUintMap(key string, opts ...Option) (map[string]uint, error) {
+	return GetFrom[map[string]uint](l, key, opts...)
+}
+
+// WithUint8Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to uint8, the same way the package-level WithUint8Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint8Map(key string, opts ...Option) (map[string]uint8, error) {
+	return GetFrom[map[string]uint8](l, key, opts...)
+}
+
+// WithUint16Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to uint16, the same way the package-level WithUint16Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint16Map(key string, opts ...Option) (map[string]uint16, error) {
+	return GetFrom[map[string]uint16](l, key, opts...)
+}
+
+// WithUint32Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to uint32, the same way the package-level WithUint32Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint32Map(key string, opts ...Option) (map[string]uint32, error) {
+	return GetFrom[map[string]uint32](l, key, opts...)
+}
+
+// WithUint64Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to uint64, the same way the package-level WithUint64Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint64Map(key string, opts ...Option) (map[string]uint64, error) {
+	return GetFrom[map[string]uint64](l, key, opts...)
+}
+
+// WithUintptrMap retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to uintptr, the same way the package-level WithUintptrMap does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUintptrMap(key string, opts ...Option) (map[string]uintptr, error) {
+	return GetFrom[map[string]uintptr](l, key, opts...)
+}
+
+// WithFloat32Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to float32, the same way the package-level WithFloat32Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithFloat32Map(key string, opts ...Option) (map[string]float32, error) {
+	return GetFrom[map[string]float32](l, key, opts...)
+}
+
+// WithFloat64Map retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to float64, the same way the package-level WithFloat64Map does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithFloat64Map(key string, opts ...Option) (map[string]float64, error) {
+	return GetFrom[map[string]float64](l, key, opts...)
+}
+
+// WithDurationMap retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to time.Duration, the same way the package-level WithDurationMap does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithDurationMap(key string, opts ...Option) (map[string]time.Duration, error) {
+	return GetFrom[map[string]time.Duration](l, key, opts...)
+}
+
+// WithTimeMap retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a map of string to time.Time, the same way the package-level WithTimeMap does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithTimeMap(key string, opts ...Option) (map[string]time.Time, error) {
+	return GetFrom[map[string]time.Time](l, key, opts...)
+}
+
+// WithDefaultStringMap retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]string provided.
+//
+// This method is a convenience wrapper around WithStringMap to silent the error and return a fallback value.
+func (l *Loader) WithDefaultStringMap(key string, fallback map[string]string, opts ...Option) map[string]string {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultBoolMap retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]bool provided.
+//
+// This method is a convenience wrapper around WithBoolMap to silent the error and return a fallback value.
+func (l *Loader) WithDefaultBoolMap(key string, fallback map[string]bool, opts ...Option) map[string]bool {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultIntMap retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]int provided.
+//
+// This method is a convenience wrapper around WithIntMap to silent the error and return a fallback value.
+func (l *Loader) WithDefaultIntMap(key string, fallback map[string]int, opts ...Option) map[string]int {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt8Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]int8 provided.
+//
+// This method is a convenience wrapper around WithInt8Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt8Map(key string, fallback map[string]int8, opts ...Option) map[string]int8 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt16Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]int16 provided.
+//
+// This method is a convenience wrapper around WithInt16Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt16Map(key string, fallback map[string]int16, opts ...Option) map[string]int16 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt32Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]int32 provided.
+//
+// This method is a convenience wrapper around WithInt32Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt32Map(key string, fallback map[string]int32, opts ...Option) map[string]int32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt64Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]int64 provided.
+//
+// This method is a convenience wrapper around WithInt64Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt64Map(key string, fallback map[string]int64, opts ...Option) map[string]int64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUintMap retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]uint provided.
+//
+// This method is a convenience wrapper around WithUintMap to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUintMap(key string, fallback map[string]uint, opts ...Option) map[string]uint {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint8Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]uint8 provided.
+//
+// This method is a convenience wrapper around WithUint8Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint8Map(key string, fallback map[string]uint8, opts ...Option) map[string]uint8 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint16Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]uint16 provided.
+//
+// This method is a convenience wrapper around WithUint16Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint16Map(key string, fallback map[string]uint16, opts ...Option) map[string]uint16 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint32Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]uint32 provided.
+//
+// This method is a convenience wrapper around WithUint32Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint32Map(key string, fallback map[string]uint32, opts ...Option) map[string]uint32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint64Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]uint64 provided.
+//
+// This method is a convenience wrapper around WithUint64Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint64Map(key string, fallback map[string]uint64, opts ...Option) map[string]uint64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUintptrMap retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]uintptr provided.
+//
+// This method is a convenience wrapper around WithUintptrMap to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUintptrMap(key string, fallback map[string]uintptr, opts ...Option) map[string]uintptr {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultFloat32Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]float32 provided.
+//
+// This method is a convenience wrapper around WithFloat32Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultFloat32Map(key string, fallback map[string]float32, opts ...Option) map[string]float32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultFloat64Map retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]float64 provided.
+//
+// This method is a convenience wrapper around WithFloat64Map to silent the error and return a fallback value.
+func (l *Loader) WithDefaultFloat64Map(key string, fallback map[string]float64, opts ...Option) map[string]float64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultDurationMap retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]time.Duration provided.
+//
+// This method is a convenience wrapper around WithDurationMap to silent the error and return a fallback value.
+func (l *Loader) WithDefaultDurationMap(key string, fallback map[string]time.Duration, opts ...Option) map[string]time.Duration {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultTimeMap retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default map[string]time.Time provided.
+//
+// This method is a convenience wrapper around WithTimeMap to silent the error and return a fallback value.
+func (l *Loader) WithDefaultTimeMap(key string, fallback map[string]time.Time, opts ...Option) map[string]time.Time {
+	return GetOrFrom(l, key, fallback, opts...)
+}
@@ -0,0 +1,176 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"testing"
+)
+
+func TestWithStringMap(t *testing.T) {
+	cases := []testutils.TestCase[map[string]string]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: map[string]string{"team": "core", "env": "prod"},
+			Env: map[string]string{
+				"FOO": "team=core,env=prod",
+			},
+		},
+		{
+			Name:     "trims the whitespaces and keeps separators in values",
+			Given:    "FOO",
+			Expected: map[string]string{"team": "core", "query": "a=b"},
+			Env: map[string]string{
+				"FOO": " team = core , query=a=b",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if a pair has no separator",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "team=core,env",
+			},
+		},
+		{
+			Name:       "returns ErrDuplicateKey if a key is declared twice",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrDuplicateKey,
+			Env: map[string]string{
+				"FOO": "env=dev,env=prod",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithStringMap(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithStringMap_Options(t *testing.T) {
+	t.Setenv("FOO", "X-Env: dev; X-Env: prod; ; X-Team: core")
+
+	first, err := WithStringMap("FOO", Separator(";"), KeyValueSeparator(":"), SkipEmpty(), DuplicateKeys(DuplicateKeepFirst))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Env": "dev", "X-Team": "core"}, first)
+
+	last, err := WithStringMap("FOO", Separator(";"), KeyValueSeparator(":"), SkipEmpty(), DuplicateKeys(DuplicateKeepLast))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"X-Env": "prod", "X-Team": "core"}, last)
+}
+
+func TestWithIntMap(t *testing.T) {
+	cases := []testutils.TestCase[map[string]int]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: map[string]int{"free": 10, "pro": 100},
+			Env: map[string]string{
+				"FOO": "free=10,pro=100",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if a value is not an integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "free=10,pro=unlimited",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithIntMap(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithIntMap_ErrorReportsPair(t *testing.T) {
+	t.Setenv("FOO", "free=10,pro=unlimited")
+
+	_, err := WithIntMap("FOO")
+
+	assert.ErrorContains(t, err, "could not parse value of pair 1")
+}
+
+func TestWithDefaultBoolMap(t *testing.T) {
+	cases := []testutils.TestCase[map[string]bool]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: map[string]bool{"beta": true},
+			Env: map[string]string{
+				"FOO": "beta=true",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: map[string]bool{"beta": false},
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: map[string]bool{"beta": false},
+			Env: map[string]string{
+				"FOO": "beta=maybe",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultBoolMap(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestLoader_WithFloat64Map(t *testing.T) {
+	l := NewLoader(Map{"RATIOS": "a:0.5 b:1.5"}, Separator(" "), KeyValueSeparator(":"))
+
+	received, err := l.WithFloat64Map("RATIOS")
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]float64{"a": 0.5, "b": 1.5}, received)
+}
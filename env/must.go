@@ -0,0 +1,127 @@
+package env
+
+import "time"
+
+// Must is a helper that wraps a call to a function returning (T, error) and
+// panics if the error is non-nil. It is intended for package-level variable
+// initialisation and main functions, and works with any getter, including the
+// Loader ones:
+//
+//	var port = env.Must(loader.WithInt("PORT"))
+//
+// The panic value is the error itself, a *VarError for the getters of this
+// package, so that it can be recovered and inspected with errors.As.
+func Must[T any](val T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
+// MustGet is like Get but panics if the variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustGet[T any](key string, opts ...Option) T {
+	return Must(Get[T](key, opts...))
+}
+
+// MustString is like WithString but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustString(key string, opts ...Option) string {
+	return Must(WithString(key, opts...))
+}
+
+// MustBool is like WithBool but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustBool(key string, opts ...Option) bool {
+	return Must(WithBool(key, opts...))
+}
+
+// MustInt is like WithInt but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt(key string, opts ...Option) int {
+	return Must(WithInt(key, opts...))
+}
+
+// MustInt8 is like WithInt8 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt8(key string, opts ...Option) int8 {
+	return Must(WithInt8(key, opts...))
+}
+
+// MustInt16 is like WithInt16 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt16(key string, opts ...Option) int16 {
+	return Must(WithInt16(key, opts...))
+}
+
+// MustInt32 is like WithInt32 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt32(key string, opts ...Option) int32 {
+	return Must(WithInt32(key, opts...))
+}
+
+// MustInt64 is like WithInt64 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt64(key string, opts ...Option) int64 {
+	return Must(WithInt64(key, opts...))
+}
+
+// MustUint is like WithUint but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint(key string, opts ...Option) uint {
+	return Must(WithUint(key, opts...))
+}
+
+// MustUint8 is like WithUint8 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint8(key string, opts ...Option) uint8 {
+	return Must(WithUint8(key, opts...))
+}
+
+// MustUint16 is like WithUint16 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint16(key string, opts ...Option) uint16 {
+	return Must(WithUint16(key, opts...))
+}
+
+// MustUint32 is like WithUint32 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint32(key string, opts ...Option) uint32 {
+	return Must(WithUint32(key, opts...))
+}
+
+// MustUint64 is like WithUint64 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint64(key string, opts ...Option) uint64 {
+	return Must(WithUint64(key, opts...))
+}
+
+// MustUintptr is like WithUintptr but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUintptr(key string, opts ...Option) uintptr {
+	return Must(WithUintptr(key, opts...))
+}
+
+// MustFloat32 is like WithFloat32 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustFloat32(key string, opts ...Option) float32 {
+	return Must(WithFloat32(key, opts...))
+}
+
+// MustFloat64 is like WithFloat64 but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustFloat64(key string, opts ...Option) float64 {
+	return Must(WithFloat64(key, opts...))
+}
+
+// MustDuration is like WithDuration but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustDuration(key string, opts ...Option) time.Duration {
+	return Must(WithDuration(key, opts...))
+}
+
+// MustTime is like WithTime but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustTime(key string, opts ...Option) time.Time {
+	return Must(WithTime(key, opts...))
+}
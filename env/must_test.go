@@ -0,0 +1,62 @@
+package env
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMust(t *testing.T) {
+	assert.Equal(t, 10, Must(10, nil))
+
+	errBoom := errors.New("boom")
+	assert.PanicsWithError(t, errBoom.Error(), func() {
+		Must(10, errBoom)
+	})
+}
+
+func TestMustInt(t *testing.T) {
+	t.Run("returns the value", func(t *testing.T) {
+		t.Setenv("FOO", "10")
+		assert.Equal(t, 10, MustInt("FOO"))
+	})
+
+	t.Run("panics with a VarError if the env var is not defined", func(t *testing.T) {
+		defer func() {
+			err, ok := recover().(error)
+			assert.True(t, ok)
+
+			var varErr *VarError
+			assert.True(t, errors.As(err, &varErr))
+			assert.Equal(t, "FOO", varErr.Key)
+			assert.ErrorIs(t, err, ErrUndefinedVariable)
+			assert.EqualError(t, err, "FOO: "+ErrUndefinedVariable.Error())
+		}()
+
+		MustInt("FOO")
+		t.Fatal("MustInt should have panicked")
+	})
+
+	t.Run("panics if the value cannot be parsed", func(t *testing.T) {
+		t.Setenv("FOO", "notaninteger")
+		assert.Panics(t, func() {
+			MustInt("FOO")
+		})
+	})
+}
+
+func TestMustFamilies(t *testing.T) {
+	t.Setenv("STRING", "hello")
+	t.Setenv("DURATION", "1m")
+	t.Setenv("TIME", "2024-05-01")
+	t.Setenv("SLICE", "1;2")
+	t.Setenv("MAP", "a=true")
+
+	assert.Equal(t, "hello", MustString("STRING"))
+	assert.Equal(t, time.Minute, MustDuration("DURATION"))
+	assert.Equal(t, time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC), MustTime("TIME", Layout(time.DateOnly)))
+	assert.Equal(t, []uint8{1, 2}, MustUint8Slice("SLICE", Separator(";")))
+	assert.Equal(t, map[string]bool{"a": true}, MustBoolMap("MAP"))
+	assert.Equal(t, []string{"1", "2"}, MustGet[[]string]("SLICE", Separator(";")))
+}
@@ -0,0 +1,210 @@
+package env
+
+import (
+	"encoding/base64"
+	"time"
+)
+
+// Option configures how variables are retrieved and parsed. Options given to
+// NewLoader apply to every lookup of the loader, options given to a single
+// getter call apply on top of them for that call only.
+type Option func(*options)
+
+// options holds the settings configured through Option.
+type options struct {
+	layout               string
+	separator            string
+	kvSeparator          string
+	skipEmpty            bool
+	allowEmpty           bool
+	expand               bool
+	fileSuffix           string
+	maxFileSize          int64
+	sensitive            bool
+	duplicates           DuplicatePolicy
+	validators           []validator
+	collectionValidators []validator
+	schemes              []string
+	parse                parseFunc
+	strictBool           bool
+	trueValues           []string
+	falseValues          []string
+	allowUnknownFields   bool
+	base64               *base64.Encoding
+}
+
+// defaultOptions returns the settings used when no Option is given.
+func defaultOptions() options {
+	return options{
+		layout:      time.RFC3339,
+		separator:   ",",
+		kvSeparator: "=",
+		maxFileSize: DefaultMaxFileSize,
+		base64:      base64.StdEncoding,
+	}
+}
+
+// Layout sets the layout used to parse time.Time values, as understood by
+// time.Parse. Defaults to time.RFC3339.
+func Layout(layout string) Option {
+	return func(o *options) {
+		o.layout = layout
+	}
+}
+
+// Separator sets the separator between the elements of slice values and between
+// the pairs of map values. Defaults to ",". Elements are always trimmed of their
+// leading and trailing whitespaces.
+func Separator(sep string) Option {
+	return func(o *options) {
+		o.separator = sep
+	}
+}
+
+// SkipEmpty drops the empty elements of slice values and the empty pairs of map
+// values instead of parsing them, e.g. "a,,b" is parsed as a two elements slice.
+func SkipEmpty() Option {
+	return func(o *options) {
+		o.skipEmpty = true
+	}
+}
+
+// KeyValueSeparator sets the separator between the key and the value of each pair
+// of map values. Defaults to "=". Only the first occurrence splits the pair, so
+// the value may contain the separator.
+func KeyValueSeparator(sep string) Option {
+	return func(o *options) {
+		o.kvSeparator = sep
+	}
+}
+
+// DuplicatePolicy defines how map values declaring the same key several times are handled.
+type DuplicatePolicy int
+
+const (
+	// DuplicateError fails the parsing with ErrDuplicateKey. This is the default policy.
+	DuplicateError DuplicatePolicy = iota
+	// DuplicateKeepFirst keeps the value of the first occurrence of the key.
+	DuplicateKeepFirst
+	// DuplicateKeepLast keeps the value of the last occurrence of the key.
+	DuplicateKeepLast
+)
+
+// DuplicateKeys sets the policy applied when a map value declares the same key
+// several times. Defaults to DuplicateError.
+func DuplicateKeys(policy DuplicatePolicy) Option {
+	return func(o *options) {
+		o.duplicates = policy
+	}
+}
+
+// AllowEmpty accepts variables set to an empty value instead of failing with
+// ErrEmptyVariable. The empty value is handed to the parser, so it is returned
+// as is for strings, as an empty slice or map for slice and map values, and
+// fails to parse for most other types.
+func AllowEmpty() Option {
+	return func(o *options) {
+		o.allowEmpty = true
+	}
+}
+
+// Expand enables the expansion of the references to other variables in values,
+// e.g. DATABASE_URL=postgres://${DB_USER}@${DB_HOST}/app. Referenced variables
+// are read from the same source as the variable itself and are expanded too.
+// The following forms are supported:
+//
+//   - $VAR and ${VAR} are replaced by the value of VAR, or by an empty string if it is unset.
+//   - ${VAR:-default} is replaced by default if VAR is unset or empty, ${VAR-default} only if VAR is unset.
+//   - ${VAR:?message} fails with ErrRequiredVariable and the message if VAR is unset or empty,
+//     ${VAR?message} only if VAR is unset.
+//   - $$ and \$ are replaced by a literal $.
+//
+// ErrExpansionCycle is returned if a variable references itself, directly or not.
+func Expand() Option {
+	return func(o *options) {
+		o.expand = true
+	}
+}
+
+// FileFallback reads the value of an unset variable from the file referenced by
+// the variable of the same name suffixed by _FILE, following the convention of
+// Docker and Kubernetes secrets, e.g. DB_PASSWORD_FILE=/run/secrets/db_password.
+// The trailing newlines of the file content are trimmed, and the content is not
+// expanded by the Expand option.
+//
+// ErrConflictingVariables is returned if both the variable and its _FILE variant
+// are set, ErrUndefinedVariable if neither of them is.
+func FileFallback() Option {
+	return FileSuffix("_FILE")
+}
+
+// FileSuffix is like FileFallback, but with a custom suffix for the variables
+// referencing files. An empty suffix disables the file fallback.
+func FileSuffix(suffix string) Option {
+	return func(o *options) {
+		o.fileSuffix = suffix
+	}
+}
+
+// MaxFileSize sets the maximum size, in bytes, of the files read with the
+// FileFallback option. Defaults to DefaultMaxFileSize.
+func MaxFileSize(size int64) Option {
+	return func(o *options) {
+		o.maxFileSize = size
+	}
+}
+
+// Sensitive redacts the raw value of the variable from the errors, as VarError.Redact
+// does. It is implied for variables retrieved as a Secret.
+func Sensitive() Option {
+	return func(o *options) {
+		o.sensitive = true
+	}
+}
+
+// Schemes restricts the schemes accepted for URL values, e.g. Schemes("http", "https").
+// Schemes are compared case-insensitively. Any scheme is accepted by default.
+func Schemes(schemes ...string) Option {
+	return func(o *options) {
+		o.schemes = schemes
+	}
+}
+
+// StrictBool only accepts the boolean values understood by strconv.ParseBool,
+// i.e. 1, t, T, TRUE, true, True, 0, f, F, FALSE, false and False.
+func StrictBool() Option {
+	return func(o *options) {
+		o.strictBool = true
+	}
+}
+
+// BoolValues replaces the vocabulary of the boolean values, which are matched
+// case-insensitively after trimming their whitespaces. Defaults to 1, t, true,
+// y, yes, on, enable and enabled for true, and to 0, f, false, n, no, off,
+// disable and disabled for false. A nil or empty list keeps the default values
+// of its side, e.g. BoolValues([]string{"si"}, nil) still accepts no and off.
+func BoolValues(trueValues, falseValues []string) Option {
+	return func(o *options) {
+		o.trueValues = trueValues
+		o.falseValues = falseValues
+	}
+}
+
+// AllowUnknownFields accepts the JSON objects declaring fields that do not exist
+// in the destination of WithJSON, which are rejected by default.
+func AllowUnknownFields() Option {
+	return func(o *options) {
+		o.allowUnknownFields = true
+	}
+}
+
+// Base64Encoding sets the encoding used to decode base64 values, e.g.
+// base64.URLEncoding or base64.RawStdEncoding for unpadded values. Defaults to
+// base64.StdEncoding, which is also kept if enc is nil.
+func Base64Encoding(enc *base64.Encoding) Option {
+	return func(o *options) {
+		if enc != nil {
+			o.base64 = enc
+		}
+	}
+}
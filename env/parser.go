@@ -0,0 +1,181 @@
+package env
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// parseFunc parses a raw environment variable value into a value of a given type,
+// according to the options of the lookup.
+type parseFunc func(string, *options) (any, error)
+
+var (
+	parsersMu sync.RWMutex
+	parsers   = map[reflect.Type]parseFunc{
+		reflect.TypeOf(""): func(v string, _ *options) (any, error) {
+			return v, nil
+		},
+		reflect.TypeOf(false): func(v string, o *options) (any, error) {
+			return parseBool(v, o)
+		},
+		reflect.TypeOf(int(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseInt(v, 0, 0)
+			return int(parsed), err
+		},
+		reflect.TypeOf(int8(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseInt(v, 0, 8)
+			return int8(parsed), err
+		},
+		reflect.TypeOf(int16(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseInt(v, 0, 16)
+			return int16(parsed), err
+		},
+		reflect.TypeOf(int32(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseInt(v, 0, 32)
+			return int32(parsed), err
+		},
+		reflect.TypeOf(int64(0)): func(v string, _ *options) (any, error) {
+			return parseInt(v, 0, 64)
+		},
+		reflect.TypeOf(uint(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseUint(v, 0, 0)
+			return uint(parsed), err
+		},
+		reflect.TypeOf(uint8(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseUint(v, 0, 8)
+			return uint8(parsed), err
+		},
+		reflect.TypeOf(uint16(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseUint(v, 0, 16)
+			return uint16(parsed), err
+		},
+		reflect.TypeOf(uint32(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseUint(v, 0, 32)
+			return uint32(parsed), err
+		},
+		reflect.TypeOf(uint64(0)): func(v string, _ *options) (any, error) {
+			return parseUint(v, 0, 64)
+		},
+		reflect.TypeOf(uintptr(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseUintptr(v)
+			return uintptr(parsed), err
+		},
+		reflect.TypeOf(float32(0)): func(v string, _ *options) (any, error) {
+			parsed, err := parseFloat(v, 32)
+			return float32(parsed), err
+		},
+		reflect.TypeOf(float64(0)): func(v string, _ *options) (any, error) {
+			return parseFloat(v, 64)
+		},
+		reflect.TypeOf(time.Duration(0)): func(v string, _ *options) (any, error) {
+			return parseDuration(v)
+		},
+		reflect.TypeOf(time.Time{}): func(v string, o *options) (any, error) {
+			return parseTime(v, o.layout)
+		},
+		secretType: func(v string, _ *options) (any, error) {
+			return NewSecret(v), nil
+		},
+		reflect.TypeOf((*url.URL)(nil)): func(v string, o *options) (any, error) {
+			return parseURL(v, o.schemes)
+		},
+		reflect.TypeOf(HostPort{}): func(v string, _ *options) (any, error) {
+			return parseHostPort(v)
+		},
+		reflect.TypeOf(netip.Addr{}): func(v string, _ *options) (any, error) {
+			return parseIP(v)
+		},
+		reflect.TypeOf(netip.Prefix{}): func(v string, _ *options) (any, error) {
+			return parseIPNet(v)
+		},
+	}
+)
+
+// RegisterParser registers the function used by Get, GetOr and Bind to parse
+// values of type T. Registering a parser for a type that already has one
+// replaces it, including the built-in ones.
+//
+// It is safe to call RegisterParser concurrently, although parsers are
+// usually registered once from an init function.
+func RegisterParser[T any](parse func(string) (T, error)) {
+	parsersMu.Lock()
+	defer parsersMu.Unlock()
+
+	parsers[typeOf[T]()] = func(v string, _ *options) (any, error) {
+		return parse(v)
+	}
+}
+
+// parserFor returns the parser registered for the type t. Types without a
+// registered parser are decoded with their encoding.TextUnmarshaler, flag.Value
+// or json.Unmarshaler implementation if any. Otherwise, types whose underlying
+// type has a parser (e.g. `type Port int`) are parsed as their underlying type
+// and converted back. Slices and maps of supported types are split according to
+// the options and parsed element by element.
+//
+// ErrUnsupportedType is returned if no parser can handle the type.
+func parserFor(t reflect.Type) (parseFunc, error) {
+	parsersMu.RLock()
+	defer parsersMu.RUnlock()
+
+	return lookupParser(t)
+}
+
+// lookupParser is the implementation of parserFor, it expects the caller to hold parsersMu.
+func lookupParser(t reflect.Type) (parseFunc, error) {
+	if p, ok := parsers[t]; ok {
+		return p, nil
+	}
+
+	if p := unmarshalerParser(t); p != nil {
+		return p, nil
+	}
+
+	for base, p := range parsers {
+		if base.Kind() != t.Kind() || base.PkgPath() != "" || !base.ConvertibleTo(t) {
+			continue
+		}
+		return func(v string, o *options) (any, error) {
+			parsed, err := p(v, o)
+			if err != nil {
+				return nil, err
+			}
+			return reflect.ValueOf(parsed).Convert(t).Interface(), nil
+		}, nil
+	}
+
+	if t.Kind() == reflect.Slice {
+		elem, err := lookupParser(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return func(v string, o *options) (any, error) {
+			return parseSlice(v, t, elem, o)
+		}, nil
+	}
+
+	if t.Kind() == reflect.Map {
+		key, err := lookupParser(t.Key())
+		if err != nil {
+			return nil, err
+		}
+		elem, err := lookupParser(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return func(v string, o *options) (any, error) {
+			return parseMap(v, t, key, elem, o)
+		}, nil
+	}
+
+	return nil, fmt.Errorf("%w %s", ErrUnsupportedType, t)
+}
+
+// typeOf returns the reflect.Type of T, including when T is an interface type.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
@@ -0,0 +1,53 @@
+package env
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type parserTestLevel struct {
+	name string
+}
+
+func TestRegisterParser(t *testing.T) {
+	errUnknownLevel := errors.New("unknown level")
+
+	RegisterParser(func(v string) (parserTestLevel, error) {
+		switch v := strings.ToLower(v); v {
+		case "debug", "info":
+			return parserTestLevel{name: v}, nil
+		default:
+			return parserTestLevel{}, errUnknownLevel
+		}
+	})
+
+	t.Run("Get uses the registered parser", func(t *testing.T) {
+		t.Setenv("LEVEL", "DEBUG")
+
+		received, err := Get[parserTestLevel]("LEVEL")
+
+		assert.NoError(t, err)
+		assert.Equal(t, parserTestLevel{name: "debug"}, received)
+	})
+
+	t.Run("Get returns the parser error", func(t *testing.T) {
+		t.Setenv("LEVEL", "trace")
+
+		_, err := Get[parserTestLevel]("LEVEL")
+
+		assert.ErrorIs(t, err, errUnknownLevel)
+	})
+
+	t.Run("Bind uses the registered parser", func(t *testing.T) {
+		t.Setenv("LEVEL", "info")
+
+		var cfg struct {
+			Level parserTestLevel `env:"LEVEL"`
+		}
+
+		assert.NoError(t, Bind(&cfg))
+		assert.Equal(t, parserTestLevel{name: "info"}, cfg.Level)
+	})
+}
@@ -0,0 +1,119 @@
+package env
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+)
+
+const redacted = "[REDACTED]"
+
+// Secret holds a sensitive string value, such as a password or a token, that is
+// redacted whenever it is printed, marshalled or logged. The actual value is only
+// accessible through Reveal.
+//
+// Secret fields are supported by Bind, and errors related to variables retrieved
+// as a Secret never include their raw value.
+type Secret struct {
+	value string
+}
+
+var secretType = reflect.TypeOf(Secret{})
+
+// NewSecret returns a Secret holding the given value.
+func NewSecret(value string) Secret {
+	return Secret{value: value}
+}
+
+// Reveal returns the actual value of the secret.
+func (s Secret) Reveal() string {
+	return s.value
+}
+
+// String returns a redacted form of the secret.
+func (s Secret) String() string {
+	return redacted
+}
+
+// GoString returns a redacted form of the secret, used by the %#v verb.
+func (s Secret) GoString() string {
+	return "env.Secret{" + redacted + "}"
+}
+
+// Format implements fmt.Formatter, so that the secret is redacted whatever the verb.
+func (s Secret) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, s.GoString())
+		return
+	}
+	io.WriteString(f, s.String())
+}
+
+// MarshalJSON encodes a redacted form of the secret.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return json.Marshal(redacted)
+}
+
+// MarshalText encodes a redacted form of the secret.
+func (s Secret) MarshalText() ([]byte, error) {
+	return []byte(redacted), nil
+}
+
+// LogValue implements slog.LogValuer, so that the secret is redacted in logs.
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
+// WithSecret retrieves the value of an environment variable identified by the key as a Secret.
+// Errors related to the variable never include its raw value.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithSecret(key string, opts ...Option) (Secret, error) {
+	return Get[Secret](key, opts...)
+}
+
+// WithDefaultSecret retrieves the value of an environment variable identified by the key as a Secret.
+// If the environment variable is not set or empty, it returns the fallback default Secret provided.
+//
+// This method is a convenience wrapper around WithSecret to silent the error and return a fallback value.
+func WithDefaultSecret(key string, fallback Secret, opts ...Option) Secret {
+	return GetOr(key, fallback, opts...)
+}
+
+// MustSecret is like WithSecret but panics if the environment variable cannot be retrieved.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustSecret(key string, opts ...Option) Secret {
+	return Must(WithSecret(key, opts...))
+}
+
+// WithSecret retrieves the value of the variable identified by the key from the loader's source as a Secret.
+// Errors related to the variable never include its raw value.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithSecret(key string, opts ...Option) (Secret, error) {
+	return GetFrom[Secret](l, key, opts...)
+}
+
+// WithDefaultSecret retrieves the value of the variable identified by the key from the loader's source as a Secret.
+// If the variable is not set or empty, it returns the fallback default Secret provided.
+//
+// This method is a convenience wrapper around WithSecret to silent the error and return a fallback value.
+func (l *Loader) WithDefaultSecret(key string, fallback Secret, opts ...Option) Secret {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// isSensitive reports whether the values of the type t hold secrets.
+func isSensitive(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice:
+		return isSensitive(t.Elem())
+	case reflect.Map:
+		return isSensitive(t.Key()) || isSensitive(t.Elem())
+	default:
+		return t == secretType
+	}
+}
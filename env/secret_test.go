@@ -0,0 +1,86 @@
+package env
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"testing"
+)
+
+func TestSecret_Redaction(t *testing.T) {
+	s := NewSecret("s3cr3t")
+
+	assert.Equal(t, "s3cr3t", s.Reveal())
+
+	for _, format := range []string{"%s", "%v", "%+v", "%#v", "%q", "%x", "%d"} {
+		assert.NotContains(t, fmt.Sprintf(format, s), "s3cr3t", format)
+	}
+
+	cfg := struct {
+		User     string
+		Password Secret
+	}{User: "admin", Password: s}
+
+	assert.Equal(t, "{admin [REDACTED]}", fmt.Sprintf("%v", cfg))
+	assert.NotContains(t, fmt.Sprintf("%#v", cfg), "s3cr3t")
+
+	encoded, err := json.Marshal(cfg)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"User":"admin","Password":"[REDACTED]"}`, string(encoded))
+
+	var buf bytes.Buffer
+	slog.New(slog.NewJSONHandler(&buf, nil)).Info("config", "password", s, "config", cfg)
+	assert.NotContains(t, buf.String(), "s3cr3t")
+	assert.Contains(t, buf.String(), `"password":"[REDACTED]"`)
+}
+
+func TestWithSecret(t *testing.T) {
+	t.Setenv("DB_PASSWORD", "s3cr3t")
+
+	s, err := WithSecret("DB_PASSWORD")
+	assert.NoError(t, err)
+	assert.Equal(t, "s3cr3t", s.Reveal())
+
+	_, err = WithSecret("UNSET")
+	assert.ErrorIs(t, err, ErrUndefinedVariable)
+	assert.EqualError(t, err, "UNSET: "+ErrUndefinedVariable.Error())
+
+	assert.Equal(t, "fallback", WithDefaultSecret("UNSET", NewSecret("fallback")).Reveal())
+}
+
+func TestSensitive(t *testing.T) {
+	l := NewLoader(Map{
+		"TOKEN":  "s3cr3t",
+		"SECRET": "${s3cr3t",
+	})
+
+	_, err := l.WithInt("TOKEN", Sensitive())
+	assert.EqualError(t, err, "TOKEN: invalid int value (redacted)")
+
+	_, err = l.WithSecret("SECRET", Expand())
+	assert.NotContains(t, err.Error(), "s3cr3t")
+
+	var varErr *VarError
+	assert.ErrorAs(t, err, &varErr)
+	assert.Empty(t, varErr.Value)
+}
+
+func TestBind_Secret(t *testing.T) {
+	l := NewLoader(Map{
+		"PASSWORD": "s3cr3t",
+		"PIN":      "s3cr3t",
+	})
+
+	var cfg struct {
+		Password Secret `env:"PASSWORD"`
+		Pin      int    `env:"PIN,secret"`
+	}
+
+	err := l.Bind(&cfg)
+
+	assert.Equal(t, "s3cr3t", cfg.Password.Reveal())
+	assert.Error(t, err)
+	assert.NotContains(t, err.Error(), "s3cr3t")
+}
@@ -0,0 +1,103 @@
+package env
+
+// WithBytes retrieves the value of an environment variable identified by the key
+// and tries to parse it as a size in bytes, e.g. 512MiB or 1.5GB. Both the SI (kB,
+// MB, GB, ...) and IEC (KiB, MiB, GiB, ...) units are supported case-insensitively,
+// the trailing B being optional, and values without a unit are counted in bytes.
+// Fractions of a byte resulting from a unit, e.g. 0.1KiB, are truncated, while a
+// fractional number of bytes, e.g. 1.5, is an error. An error is also returned if
+// the parsing fails or if the size overflows an uint64.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithBytes(key string, opts ...Option) (uint64, error) {
+	return Get[uint64](key, withParser(opts, bytesParser)...)
+}
+
+// WithPercent retrieves the value of an environment variable identified by the key
+// and tries to parse it as a percentage, e.g. 80% or 0.8, returned as a fraction
+// between 0 and 1. An error is returned if the parsing fails or if the value is out of range.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithPercent(key string, opts ...Option) (float64, error) {
+	return Get[float64](key, withParser(opts, percentParser)...)
+}
+
+// WithDefaultBytes retrieves the value of an environment variable identified by the key as a size in bytes.
+// If the environment variable is not set, empty or cannot be parsed, it returns the fallback default uint64 provided.
+//
+// This method is a convenience wrapper around WithBytes to silent the error and return a fallback value.
+func WithDefaultBytes(key string, fallback uint64, opts ...Option) uint64 {
+	return GetOr(key, fallback, withParser(opts, bytesParser)...)
+}
+
+// WithDefaultPercent retrieves the value of an environment variable identified by the key as a percentage.
+// If the environment variable is not set, empty or cannot be parsed, it returns the fallback default float64 provided.
+//
+// This method is a convenience wrapper around WithPercent to silent the error and return a fallback value.
+func WithDefaultPercent(key string, fallback float64, opts ...Option) float64 {
+	return GetOr(key, fallback, withParser(opts, percentParser)...)
+}
+
+// MustBytes is like WithBytes but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustBytes(key string, opts ...Option) uint64 {
+	return Must(WithBytes(key, opts...))
+}
+
+// MustPercent is like WithPercent but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustPercent(key string, opts ...Option) float64 {
+	return Must(WithPercent(key, opts...))
+}
+
+// WithBytes retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a size in bytes, the same way the package-level WithBytes does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithBytes(key string, opts ...Option) (uint64, error) {
+	return GetFrom[uint64](l, key, withParser(opts, bytesParser)...)
+}
+
+// WithPercent retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a percentage, the same way the package-level WithPercent does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithPercent(key string, opts ...Option) (float64, error) {
+	return GetFrom[float64](l, key, withParser(opts, percentParser)...)
+}
+
+// WithDefaultBytes retrieves the value of the variable identified by the key from the loader's source as a size in bytes.
+// If the variable is not set, empty or cannot be parsed, it returns the fallback default uint64 provided.
+//
+// This method is a convenience wrapper around WithBytes to silent the error and return a fallback value.
+func (l *Loader) WithDefaultBytes(key string, fallback uint64, opts ...Option) uint64 {
+	return GetOrFrom(l, key, fallback, withParser(opts, bytesParser)...)
+}
+
+// WithDefaultPercent retrieves the value of the variable identified by the key from the loader's source as a percentage.
+// If the variable is not set, empty or cannot be parsed, it returns the fallback default float64 provided.
+//
+// This method is a convenience wrapper around WithPercent to silent the error and return a fallback value.
+func (l *Loader) WithDefaultPercent(key string, fallback float64, opts ...Option) float64 {
+	return GetOrFrom(l, key, fallback, withParser(opts, percentParser)...)
+}
+
+func bytesParser(v string, _ *options) (any, error) {
+	return parseBytes(v)
+}
+
+func percentParser(v string, _ *options) (any, error) {
+	return parsePercent(v)
+}
+
+// withParser returns the options completed with one replacing the parser of the
+// requested type, without altering the given slice.
+func withParser(opts []Option, p parseFunc) []Option {
+	return append(opts[:len(opts):len(opts)], func(o *options) {
+		o.parse = p
+	})
+}
@@ -0,0 +1,89 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"strconv"
+	"testing"
+)
+
+func TestWithBytes(t *testing.T) {
+	cases := []testutils.TestCase[uint64]{
+		{Name: "parses a value without unit as bytes", Given: "1024", Expected: 1024},
+		{Name: "parses SI units", Given: "1.5GB", Expected: 1_500_000_000},
+		{Name: "parses IEC units", Given: "512MiB", Expected: 512 << 20},
+		{Name: "parses units case-insensitively without the trailing B", Given: " 2 ki ", Expected: 2048},
+		{Name: "truncates fractions of a byte", Given: "0.1KiB", Expected: 102},
+		{Name: "accepts a whole number of bytes written with a fraction", Given: "2.0B", Expected: 2},
+		{Name: "returns an error on fractions of bytes without unit", Given: "1.5", ShouldFail: true},
+		{Name: "returns an error on fractions of bytes", Given: "0.5B", ShouldFail: true},
+		{Name: "parses the maximum value", Given: "18446744073709551615", Expected: 1<<64 - 1},
+		{Name: "returns an error on overflow", Given: "16EiB", ShouldFail: true, Error: strconv.ErrRange},
+		{Name: "returns an error on negative values", Given: "-1MB", ShouldFail: true},
+		{Name: "returns an error on unknown units", Given: "10XB", ShouldFail: true},
+		{Name: "returns an error on invalid numbers", Given: "1.2.3MB", ShouldFail: true},
+		{Name: "returns an error on missing numbers", Given: "MB", ShouldFail: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			received, err := NewLoader(Map{"SIZE": tc.Given}).WithBytes("SIZE")
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithPercent(t *testing.T) {
+	cases := []testutils.TestCase[float64]{
+		{Name: "parses a percentage", Given: "80%", Expected: 0.8},
+		{Name: "parses a fraction", Given: "0.25", Expected: 0.25},
+		{Name: "accepts whitespaces", Given: " 12.5 % ", Expected: 0.125},
+		{Name: "accepts the bounds", Given: "100%", Expected: 1},
+		{Name: "returns an error above 100%", Given: "120%", ShouldFail: true},
+		{Name: "returns an error above 1", Given: "1.5", ShouldFail: true},
+		{Name: "returns an error below 0", Given: "-5%", ShouldFail: true},
+		{Name: "returns an error on NaN", Given: "NaN", ShouldFail: true},
+		{Name: "returns an error on invalid values", Given: "half", ShouldFail: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			received, err := NewLoader(Map{"RATIO": tc.Given}).WithPercent("RATIO")
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.InDelta(t, tc.Expected, received, 1e-9)
+			}
+		})
+	}
+}
+
+func TestSize_Defaults(t *testing.T) {
+	t.Setenv("CACHE_SIZE", "64MB")
+	t.Setenv("CPU_TARGET", "eighty")
+
+	assert.Equal(t, uint64(64_000_000), WithDefaultBytes("CACHE_SIZE", 1024))
+	assert.Equal(t, uint64(1024), WithDefaultBytes("UNSET", 1024))
+	assert.Equal(t, 0.5, WithDefaultPercent("CPU_TARGET", 0.5))
+
+	_, err := WithBytes("CPU_TARGET", Max(1024))
+	assert.EqualError(t, err, `CPU_TARGET: could not parse byte size: unknown unit "eighty"`)
+
+	_, err = WithBytes("CACHE_SIZE", Max(1<<20))
+	assert.ErrorIs(t, err, ErrInvalidValue)
+
+	// The regular uint64 getter is not affected by the custom parser.
+	_, err = WithUint64("CACHE_SIZE")
+	assert.Error(t, err)
+}
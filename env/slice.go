@@ -0,0 +1,716 @@
+package env
+
+import "time"
+
+// WithStringSlice retrieves the value of an environment variable identified by the key
+// and splits it into a slice of string. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithStringSlice(key string, opts ...Option) ([]string, error) {
+	return Get[[]string](key, opts...)
+}
+
+// WithBoolSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of bool. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithBoolSlice(key string, opts ...Option) ([]bool, error) {
+	return Get[[]bool](key, opts...)
+}
+
+// WithIntSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of int. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithIntSlice(key string, opts ...Option) ([]int, error) {
+	return Get[[]int](key, opts...)
+}
+
+// WithInt8Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of int8. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithInt8Slice(key string, opts ...Option) ([]int8, error) {
+	return Get[[]int8](key, opts...)
+}
+
+// WithInt16Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of int16. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithInt16Slice(key string, opts ...Option) ([]int16, error) {
+	return Get[[]int16](key, opts...)
+}
+
+// WithInt32Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of int32. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithInt32Slice(key string, opts ...Option) ([]int32, error) {
+	return Get[[]int32](key, opts...)
+}
+
+// WithInt64Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of int64. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithInt64Slice(key string, opts ...Option) ([]int64, error) {
+	return Get[[]int64](key, opts...)
+}
+
+// WithUintSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of uint. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUintSlice(key string, opts ...Option) ([]uint, error) {
+	return Get[[]uint](key, opts...)
+}
+
+// WithUint8Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of uint8. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint8Slice(key string, opts ...Option) ([]uint8, error) {
+	return Get[[]uint8](key, opts...)
+}
+
+// WithUint16Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of uint16. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint16Slice(key string, opts ...Option) ([]uint16, error) {
+	return Get[[]uint16](key, opts...)
+}
+
+// WithUint32Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of uint32. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint32Slice(key string, opts ...Option) ([]uint32, error) {
+	return Get[[]uint32](key, opts...)
+}
+
+// WithUint64Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of uint64. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUint64Slice(key string, opts ...Option) ([]uint64, error) {
+	return Get[[]uint64](key, opts...)
+}
+
+// WithUintptrSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of uintptr. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithUintptrSlice(key string, opts ...Option) ([]uintptr, error) {
+	return Get[[]uintptr](key, opts...)
+}
+
+// WithFloat32Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of float32. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithFloat32Slice(key string, opts ...Option) ([]float32, error) {
+	return Get[[]float32](key, opts...)
+}
+
+// WithFloat64Slice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of float64. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithFloat64Slice(key string, opts ...Option) ([]float64, error) {
+	return Get[[]float64](key, opts...)
+}
+
+// WithDurationSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of time.Duration. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithDurationSlice(key string, opts ...Option) ([]time.Duration, error) {
+	return Get[[]time.Duration](key, opts...)
+}
+
+// WithTimeSlice retrieves the value of an environment variable identified by the key
+// and tries to parse it as a slice of time.Time. Elements are separated by a comma, unless another
+// separator is set with the Separator option, and trimmed of their whitespaces.
+// An error reporting the index of the failing element is returned if the parsing fails.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithTimeSlice(key string, opts ...Option) ([]time.Time, error) {
+	return Get[[]time.Time](key, opts...)
+}
+
+// WithDefaultStringSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []string provided.
+//
+// This method is a convenience wrapper around WithStringSlice to silent the error and return a fallback value.
+func WithDefaultStringSlice(key string, fallback []string, opts ...Option) []string {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultBoolSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []bool provided.
+//
+// This method is a convenience wrapper around WithBoolSlice to silent the error and return a fallback value.
+func WithDefaultBoolSlice(key string, fallback []bool, opts ...Option) []bool {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultIntSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []int provided.
+//
+// This method is a convenience wrapper around WithIntSlice to silent the error and return a fallback value.
+func WithDefaultIntSlice(key string, fallback []int, opts ...Option) []int {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultInt8Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []int8 provided.
+//
+// This method is a convenience wrapper around WithInt8Slice to silent the error and return a fallback value.
+func WithDefaultInt8Slice(key string, fallback []int8, opts ...Option) []int8 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultInt16Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []int16 provided.
+//
+// This method is a convenience wrapper around WithInt16Slice to silent the error and return a fallback value.
+func WithDefaultInt16Slice(key string, fallback []int16, opts ...Option) []int16 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultInt32Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []int32 provided.
+//
+// This method is a convenience wrapper around WithInt32Slice to silent the error and return a fallback value.
+func WithDefaultInt32Slice(key string, fallback []int32, opts ...Option) []int32 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultInt64Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []int64 provided.
+//
+// This method is a convenience wrapper around WithInt64Slice to silent the error and return a fallback value.
+func WithDefaultInt64Slice(key string, fallback []int64, opts ...Option) []int64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUintSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []uint provided.
+//
+// This method is a convenience wrapper around WithUintSlice to silent the error and return a fallback value.
+func WithDefaultUintSlice(key string, fallback []uint, opts ...Option) []uint {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint8Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []uint8 provided.
+//
+// This method is a convenience wrapper around WithUint8Slice to silent the error and return a fallback value.
+func WithDefaultUint8Slice(key string, fallback []uint8, opts ...Option) []uint8 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint16Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []uint16 provided.
+//
+// This method is a convenience wrapper around WithUint16Slice to silent the error and return a fallback value.
+func WithDefaultUint16Slice(key string, fallback []uint16, opts ...Option) []uint16 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint32Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []uint32 provided.
+//
+// This method is a convenience wrapper around WithUint32Slice to silent the error and return a fallback value.
+func WithDefaultUint32Slice(key string, fallback []uint32, opts ...Option) []uint32 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUint64Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []uint64 provided.
+//
+// This method is a convenience wrapper around WithUint64Slice to silent the error and return a fallback value.
+func WithDefaultUint64Slice(key string, fallback []uint64, opts ...Option) []uint64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultUintptrSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []uintptr provided.
+//
+// This method is a convenience wrapper around WithUintptrSlice to silent the error and return a fallback value.
+func WithDefaultUintptrSlice(key string, fallback []uintptr, opts ...Option) []uintptr {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultFloat32Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []float32 provided.
+//
+// This method is a convenience wrapper around WithFloat32Slice to silent the error and return a fallback value.
+func WithDefaultFloat32Slice(key string, fallback []float32, opts ...Option) []float32 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultFloat64Slice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []float64 provided.
+//
+// This method is a convenience wrapper around WithFloat64Slice to silent the error and return a fallback value.
+func WithDefaultFloat64Slice(key string, fallback []float64, opts ...Option) []float64 {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultDurationSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []time.Duration provided.
+//
+// This method is a convenience wrapper around WithDurationSlice to silent the error and return a fallback value.
+func WithDefaultDurationSlice(key string, fallback []time.Duration, opts ...Option) []time.Duration {
+	return GetOr(key, fallback, opts...)
+}
+
+// WithDefaultTimeSlice retrieves the value of an environment variable identified by the key.
+// If the environment variable is not set or empty, it returns the fallback default []time.Time provided.
+//
+// This method is a convenience wrapper around WithTimeSlice to silent the error and return a fallback value.
+func WithDefaultTimeSlice(key string, fallback []time.Time, opts ...Option) []time.Time {
+	return GetOr(key, fallback, opts...)
+}
+
+// MustStringSlice is like WithStringSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustStringSlice(key string, opts ...Option) []string {
+	return Must(WithStringSlice(key, opts...))
+}
+
+// MustBoolSlice is like WithBoolSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustBoolSlice(key string, opts ...Option) []bool {
+	return Must(WithBoolSlice(key, opts...))
+}
+
+// MustIntSlice is like WithIntSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustIntSlice(key string, opts ...Option) []int {
+	return Must(WithIntSlice(key, opts...))
+}
+
+// MustInt8Slice is like WithInt8Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt8Slice(key string, opts ...Option) []int8 {
+	return Must(WithInt8Slice(key, opts...))
+}
+
+// MustInt16Slice is like WithInt16Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt16Slice(key string, opts ...Option) []int16 {
+	return Must(WithInt16Slice(key, opts...))
+}
+
+// MustInt32Slice is like WithInt32Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt32Slice(key string, opts ...Option) []int32 {
+	return Must(WithInt32Slice(key, opts...))
+}
+
+// MustInt64Slice is like WithInt64Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustInt64Slice(key string, opts ...Option) []int64 {
+	return Must(WithInt64Slice(key, opts...))
+}
+
+// MustUintSlice is like WithUintSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUintSlice(key string, opts ...Option) []uint {
+	return Must(WithUintSlice(key, opts...))
+}
+
+// MustUint8Slice is like WithUint8Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint8Slice(key string, opts ...Option) []uint8 {
+	return Must(WithUint8Slice(key, opts...))
+}
+
+// MustUint16Slice is like WithUint16Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint16Slice(key string, opts ...Option) []uint16 {
+	return Must(WithUint16Slice(key, opts...))
+}
+
+// MustUint32Slice is like WithUint32Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint32Slice(key string, opts ...Option) []uint32 {
+	return Must(WithUint32Slice(key, opts...))
+}
+
+// MustUint64Slice is like WithUint64Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUint64Slice(key string, opts ...Option) []uint64 {
+	return Must(WithUint64Slice(key, opts...))
+}
+
+// MustUintptrSlice is like WithUintptrSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustUintptrSlice(key string, opts ...Option) []uintptr {
+	return Must(WithUintptrSlice(key, opts...))
+}
+
+// MustFloat32Slice is like WithFloat32Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustFloat32Slice(key string, opts ...Option) []float32 {
+	return Must(WithFloat32Slice(key, opts...))
+}
+
+// MustFloat64Slice is like WithFloat64Slice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustFloat64Slice(key string, opts ...Option) []float64 {
+	return Must(WithFloat64Slice(key, opts...))
+}
+
+// MustDurationSlice is like WithDurationSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustDurationSlice(key string, opts ...Option) []time.Duration {
+	return Must(WithDurationSlice(key, opts...))
+}
+
+// MustTimeSlice is like WithTimeSlice but panics if the environment variable cannot be retrieved or parsed.
+// The panic value is the *VarError describing the key and the reason of the failure.
+func MustTimeSlice(key string, opts ...Option) []time.Time {
+	return Must(WithTimeSlice(key, opts...))
+}
+
+// WithStringSlice retrieves the value of the variable identified by the key from the loader's source
+// and splits it into a slice of string, the same way the package-level WithStringSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithStringSlice(key string, opts ...Option) ([]string, error) {
+	return GetFrom[[]string](l, key, opts...)
+}
+
+// WithBoolSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of bool, the same way the package-level WithBoolSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithBoolSlice(key string, opts ...Option) ([]bool, error) {
+	return GetFrom[[]bool](l, key, opts...)
+}
+
+// WithIntSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of int, the same way the package-level WithIntSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithIntSlice(key string, opts ...Option) ([]int, error) {
+	return GetFrom[[]int](l, key, opts...)
+}
+
+// WithInt8Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of int8, the same way the package-level WithInt8Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt8Slice(key string, opts ...Option) ([]int8, error) {
+	return GetFrom[[]int8](l, key, opts...)
+}
+
+// WithInt16Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of int16, the same way the package-level WithInt16Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt16Slice(key string, opts ...Option) ([]int16, error) {
+	return GetFrom[[]int16](l, key, opts...)
+}
+
+// WithInt32Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of int32, the same way the package-level WithInt32Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt32Slice(key string, opts ...Option) ([]int32, error) {
+	return GetFrom[[]int32](l, key, opts...)
+}
+
+// WithInt64Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of int64, the same way the package-level WithInt64Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithInt64Slice(key string, opts ...Option) ([]int64, error) {
+	return GetFrom[[]int64](l, key, opts...)
+}
+
+// WithUintSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of uint, the same way the package-level WithUintSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUintSlice(key string, opts ...Option) ([]uint, error) {
+	return GetFrom[[]uint](l, key, opts...)
+}
+
+// WithUint8Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of uint8, the same way the package-level WithUint8Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint8Slice(key string, opts ...Option) ([]uint8, error) {
+	return GetFrom[[]uint8](l, key, opts...)
+}
+
+// WithUint16Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of uint16, the same way the package-level WithUint16Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint16Slice(key string, opts ...Option) ([]uint16, error) {
+	return GetFrom[[]uint16](l, key, opts...)
+}
+
+// WithUint32Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of uint32, the same way the package-level WithUint32Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint32Slice(key string, opts ...Option) ([]uint32, error) {
+	return GetFrom[[]uint32](l, key, opts...)
+}
+
+// WithUint64Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of uint64, the same way the package-level WithUint64Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUint64Slice(key string, opts ...Option) ([]uint64, error) {
+	return GetFrom[[]uint64](l, key, opts...)
+}
+
+// WithUintptrSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of uintptr, the same way the package-level WithUintptrSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithUintptrSlice(key string, opts ...Option) ([]uintptr, error) {
+	return GetFrom[[]uintptr](l, key, opts...)
+}
+
+// WithFloat32Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of float32, the same way the package-level WithFloat32Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithFloat32Slice(key string, opts ...Option) ([]float32, error) {
+	return GetFrom[[]float32](l, key, opts...)
+}
+
+// WithFloat64Slice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of float64, the same way the package-level WithFloat64Slice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithFloat64Slice(key string, opts ...Option) ([]float64, error) {
+	return GetFrom[[]float64](l, key, opts...)
+}
+
+// WithDurationSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of time.Duration, the same way the package-level WithDurationSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithDurationSlice(key string, opts ...Option) ([]time.Duration, error) {
+	return GetFrom[[]time.Duration](l, key, opts...)
+}
+
+// WithTimeSlice retrieves the value of the variable identified by the key from the loader's source
+// and tries to parse it as a slice of time.Time, the same way the package-level WithTimeSlice does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithTimeSlice(key string, opts ...Option) ([]time.Time, error) {
+	return GetFrom[[]time.Time](l, key, opts...)
+}
+
+// WithDefaultStringSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []string provided.
+//
+// This method is a convenience wrapper around WithStringSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultStringSlice(key string, fallback []string, opts ...Option) []string {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultBoolSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []bool provided.
+//
+// This method is a convenience wrapper around WithBoolSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultBoolSlice(key string, fallback []bool, opts ...Option) []bool {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultIntSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []int provided.
+//
+// This method is a convenience wrapper around WithIntSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultIntSlice(key string, fallback []int, opts ...Option) []int {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt8Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []int8 provided.
+//
+// This method is a convenience wrapper around WithInt8Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt8Slice(key string, fallback []int8, opts ...Option) []int8 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt16Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []int16 provided.
+//
+// This method is a convenience wrapper around WithInt16Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt16Slice(key string, fallback []int16, opts ...Option) []int16 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt32Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []int32 provided.
+//
+// This method is a convenience wrapper around WithInt32Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt32Slice(key string, fallback []int32, opts ...Option) []int32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultInt64Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []int64 provided.
+//
+// This method is a convenience wrapper around WithInt64Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultInt64Slice(key string, fallback []int64, opts ...Option) []int64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUintSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []uint provided.
+//
+// This method is a convenience wrapper around WithUintSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUintSlice(key string, fallback []uint, opts ...Option) []uint {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint8Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []uint8 provided.
+//
+// This method is a convenience wrapper around WithUint8Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint8Slice(key string, fallback []uint8, opts ...Option) []uint8 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint16Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []uint16 provided.
+//
+// This method is a convenience wrapper around WithUint16Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint16Slice(key string, fallback []uint16, opts ...Option) []uint16 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint32Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []uint32 provided.
+//
+// This method is a convenience wrapper around WithUint32Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint32Slice(key string, fallback []uint32, opts ...Option) []uint32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUint64Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []uint64 provided.
+//
+// This method is a convenience wrapper around WithUint64Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUint64Slice(key string, fallback []uint64, opts ...Option) []uint64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultUintptrSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []uintptr provided.
+//
+// This method is a convenience wrapper around WithUintptrSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultUintptrSlice(key string, fallback []uintptr, opts ...Option) []uintptr {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultFloat32Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []float32 provided.
+//
+// This method is a convenience wrapper around WithFloat32Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultFloat32Slice(key string, fallback []float32, opts ...Option) []float32 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultFloat64Slice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []float64 provided.
+//
+// This method is a convenience wrapper around WithFloat64Slice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultFloat64Slice(key string, fallback []float64, opts ...Option) []float64 {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultDurationSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []time.Duration provided.
+//
+// This method is a convenience wrapper around WithDurationSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultDurationSlice(key string, fallback []time.Duration, opts ...Option) []time.Duration {
+	return GetOrFrom(l, key, fallback, opts...)
+}
+
+// WithDefaultTimeSlice retrieves the value of the variable identified by the key from the loader's source.
+// If the variable is not set or empty, it returns the fallback default []time.Time provided.
+//
+// This method is a convenience wrapper around WithTimeSlice to silent the error and return a fallback value.
+func (l *Loader) WithDefaultTimeSlice(key string, fallback []time.Time, opts ...Option) []time.Time {
+	return GetOrFrom(l, key, fallback, opts...)
+}
@@ -0,0 +1,214 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"testing"
+	"time"
+)
+
+func TestWithStringSlice(t *testing.T) {
+	cases := []testutils.TestCase[[]string]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: []string{"a.com", "b.com"},
+			Env: map[string]string{
+				"FOO": "a.com,b.com",
+			},
+		},
+		{
+			Name:     "trims the whitespaces of the elements",
+			Given:    "FOO",
+			Expected: []string{"a.com", "b.com", ""},
+			Env: map[string]string{
+				"FOO": " a.com , b.com , ",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns ErrEmptyVariable if the env var is empty",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrEmptyVariable,
+			Env: map[string]string{
+				"FOO": "",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithStringSlice(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithStringSlice_Options(t *testing.T) {
+	t.Setenv("FOO", "h1:9092; ;h2:9092;")
+
+	received, err := WithStringSlice("FOO", Separator(";"), SkipEmpty())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"h1:9092", "h2:9092"}, received)
+}
+
+func TestWithIntSlice(t *testing.T) {
+	cases := []testutils.TestCase[[]int]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: []int{1, 2, 3},
+			Env: map[string]string{
+				"FOO": "1, 2, 3",
+			},
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			Given:      "FOO",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if an element is not an integer",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "1,two,3",
+			},
+		},
+		{
+			Name:       "returns an error if an element is empty",
+			Given:      "FOO",
+			ShouldFail: true,
+			Env: map[string]string{
+				"FOO": "1,,3",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			received, err := WithIntSlice(tc.Given)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithIntSlice_ErrorReportsIndex(t *testing.T) {
+	t.Setenv("FOO", "1,two,3")
+
+	_, err := WithIntSlice("FOO")
+
+	assert.ErrorContains(t, err, "could not parse element 1")
+}
+
+func TestWithFloat64Slice(t *testing.T) {
+	t.Setenv("FOO", "0.1|2.5")
+
+	received, err := WithFloat64Slice("FOO", Separator("|"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0.1, 2.5}, received)
+}
+
+func TestWithBoolSlice(t *testing.T) {
+	t.Setenv("FOO", "true,false,,1")
+
+	received, err := WithBoolSlice("FOO", SkipEmpty())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, received)
+}
+
+func TestWithDurationSlice(t *testing.T) {
+	t.Setenv("FOO", "1s,1m")
+
+	received, err := WithDurationSlice("FOO")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Duration{time.Second, time.Minute}, received)
+}
+
+func TestWithDefaultIntSlice(t *testing.T) {
+	cases := []testutils.TestCase[[]int]{
+		{
+			Name:     "correctly retrieves the value",
+			Given:    "FOO",
+			Expected: []int{1, 2},
+			Env: map[string]string{
+				"FOO": "1,2",
+			},
+		},
+		{
+			Name:     "returns the fallback value if the env var is not defined",
+			Given:    "FOO",
+			Expected: []int{3},
+		},
+		{
+			Name:     "returns the fallback value if the parsing fails",
+			Given:    "FOO",
+			Expected: []int{3},
+			Env: map[string]string{
+				"FOO": "1,two",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+			assert.Equal(t, tc.Expected, WithDefaultIntSlice(tc.Given, tc.Expected))
+		})
+	}
+}
+
+func TestLoader_WithUint16Slice(t *testing.T) {
+	l := NewLoader(Map{"PORTS": "80;443"}, Separator(";"))
+
+	received, err := l.WithUint16Slice("PORTS")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []uint16{80, 443}, received)
+}
+
+func TestBind_Slice(t *testing.T) {
+	type hosts []string
+
+	t.Setenv("HOSTS", "a.com,b.com")
+
+	var cfg struct {
+		Hosts hosts `env:"HOSTS"`
+	}
+
+	assert.NoError(t, Bind(&cfg))
+	assert.Equal(t, hosts{"a.com", "b.com"}, cfg.Hosts)
+}
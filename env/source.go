@@ -0,0 +1,35 @@
+package env
+
+import "os"
+
+// Source is the interface implemented by the providers environment variables
+// are read from.
+//
+// Lookup retrieves the value of the variable identified by the key. The boolean
+// reports whether the variable is present, so that an empty value can be told
+// apart from an undefined one.
+type Source interface {
+	Lookup(key string) (string, bool)
+}
+
+// SourceFunc is an adapter to allow the use of ordinary functions as a Source.
+type SourceFunc func(key string) (string, bool)
+
+// Lookup calls f(key).
+func (f SourceFunc) Lookup(key string) (string, bool) {
+	return f(key)
+}
+
+// Map is a Source backed by an in-memory map of variables.
+type Map map[string]string
+
+// Lookup retrieves the value of the variable identified by the key from the map.
+func (m Map) Lookup(key string) (string, bool) {
+	val, ok := m[key]
+	return val, ok
+}
+
+// OS returns the Source backed by the process environment, through os.LookupEnv.
+func OS() Source {
+	return SourceFunc(os.LookupEnv)
+}
@@ -0,0 +1,42 @@
+package env
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMap_Lookup(t *testing.T) {
+	m := Map{"FOO": "bar", "EMPTY": ""}
+
+	val, ok := m.Lookup("FOO")
+	assert.True(t, ok)
+	assert.Equal(t, "bar", val)
+
+	val, ok = m.Lookup("EMPTY")
+	assert.True(t, ok)
+	assert.Empty(t, val)
+
+	_, ok = m.Lookup("UNSET")
+	assert.False(t, ok)
+}
+
+func TestSourceFunc_Lookup(t *testing.T) {
+	src := SourceFunc(func(key string) (string, bool) {
+		return key + "_value", key == "FOO"
+	})
+
+	val, ok := src.Lookup("FOO")
+	assert.True(t, ok)
+	assert.Equal(t, "FOO_value", val)
+
+	_, ok = src.Lookup("BAR")
+	assert.False(t, ok)
+}
+
+func TestOS_Lookup(t *testing.T) {
+	t.Setenv("FOO", "bar")
+
+	val, ok := OS().Lookup("FOO")
+	assert.True(t, ok)
+	assert.Equal(t, "bar", val)
+}
@@ -0,0 +1,94 @@
+package env
+
+import (
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+)
+
+var (
+	textUnmarshalerType = typeOf[encoding.TextUnmarshaler]()
+	jsonUnmarshalerType = typeOf[json.Unmarshaler]()
+	flagValueType       = typeOf[flag.Value]()
+)
+
+// WithText retrieves the value of an environment variable identified by the key
+// and decodes it into dst with its UnmarshalText method. It suits the types that
+// already know how to parse themselves, such as log levels or versions. dst is
+// left untouched if the variable cannot be retrieved, and an error is returned
+// if dst is nil.
+//
+// ErrUndefinedVariable is returned if the environment variable lookup fails.
+// ErrEmptyVariable is returned if the environment variable is empty.
+func WithText(key string, dst encoding.TextUnmarshaler, opts ...Option) error {
+	return std.WithText(key, dst, opts...)
+}
+
+// WithText retrieves the value of the variable identified by the key from the loader's source
+// and decodes it into dst, the same way the package-level WithText does.
+//
+// ErrUndefinedVariable is returned if the variable lookup fails.
+// ErrEmptyVariable is returned if the variable is empty.
+func (l *Loader) WithText(key string, dst encoding.TextUnmarshaler, opts ...Option) error {
+	rv := reflect.ValueOf(dst)
+	if dst == nil || rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return &VarError{Key: l.key(key), Type: fmt.Sprintf("%T", dst), Err: fmt.Errorf("could not parse %T: nil destination", dst)}
+	}
+
+	t := rv.Type()
+	o := l.options(withParser(opts, func(v string, _ *options) (any, error) {
+		if err := dst.UnmarshalText([]byte(v)); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", t, err)
+		}
+		return dst, nil
+	}))
+
+	_, err := l.get(key, t, &o)
+	return err
+}
+
+// unmarshalerParser returns a parser relying on the encoding.TextUnmarshaler,
+// flag.Value or json.Unmarshaler implementation of the type t, in this order of
+// preference, or nil if t implements none of them. Values given to UnmarshalJSON
+// are quoted as a JSON string unless they are valid JSON already.
+func unmarshalerParser(t reflect.Type) parseFunc {
+	ptr := t
+	if t.Kind() != reflect.Pointer {
+		ptr = reflect.PointerTo(t)
+	}
+
+	var unmarshal func(dst any, v string) error
+	switch {
+	case ptr.Implements(textUnmarshalerType):
+		unmarshal = func(dst any, v string) error {
+			return dst.(encoding.TextUnmarshaler).UnmarshalText([]byte(v))
+		}
+	case ptr.Implements(flagValueType):
+		unmarshal = func(dst any, v string) error {
+			return dst.(flag.Value).Set(v)
+		}
+	case ptr.Implements(jsonUnmarshalerType):
+		unmarshal = func(dst any, v string) error {
+			data := []byte(v)
+			if !json.Valid(data) {
+				data, _ = json.Marshal(v)
+			}
+			return dst.(json.Unmarshaler).UnmarshalJSON(data)
+		}
+	default:
+		return nil
+	}
+
+	return func(v string, _ *options) (any, error) {
+		dst := reflect.New(ptr.Elem())
+		if err := unmarshal(dst.Interface(), v); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", t, err)
+		}
+		if t.Kind() == reflect.Pointer {
+			return dst.Interface(), nil
+		}
+		return dst.Elem().Interface(), nil
+	}
+}
@@ -0,0 +1,171 @@
+package env
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"net"
+	"strings"
+	"testing"
+)
+
+type textTestLevel int
+
+func (l *textTestLevel) UnmarshalText(text []byte) error {
+	switch strings.ToLower(string(text)) {
+	case "debug":
+		*l = 0
+	case "info":
+		*l = 1
+	case "error":
+		*l = 2
+	default:
+		return errors.New("unknown level")
+	}
+	return nil
+}
+
+type textTestRegions []string
+
+func (r *textTestRegions) String() string {
+	return strings.Join(*r, "+")
+}
+
+func (r *textTestRegions) Set(v string) error {
+	*r = strings.Split(v, "+")
+	return nil
+}
+
+type textTestVersion struct {
+	Major, Minor int
+}
+
+func (v *textTestVersion) UnmarshalJSON(data []byte) error {
+	var raw string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	_, err := fmt.Sscanf(raw, "v%d.%d", &v.Major, &v.Minor)
+	return err
+}
+
+func TestWithText(t *testing.T) {
+	cases := []testutils.TestCase[textTestLevel]{
+		{
+			Name:     "correctly decodes the value",
+			Env:      map[string]string{"LEVEL": "ERROR"},
+			Expected: 2,
+		},
+		{
+			Name:       "returns ErrUndefinedVariable if the env var is not defined",
+			ShouldFail: true,
+			Error:      ErrUndefinedVariable,
+		},
+		{
+			Name:       "returns an error if the value cannot be decoded",
+			Env:        map[string]string{"LEVEL": "verbose"},
+			ShouldFail: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			tc.PopulateEnv(t)
+
+			var received textTestLevel
+			err := WithText("LEVEL", &received)
+
+			if tc.ShouldFail {
+				assert.Error(t, err)
+				if tc.Error != nil {
+					assert.ErrorIs(t, err, tc.Error)
+				}
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.Expected, received)
+			}
+		})
+	}
+}
+
+func TestWithText_Error(t *testing.T) {
+	l := NewLoader(Map{"APP_LEVEL": "verbose"}).WithPrefix("APP_")
+
+	var level textTestLevel
+	err := l.WithText("LEVEL", &level)
+
+	var varErr *VarError
+	assert.ErrorAs(t, err, &varErr)
+	assert.Equal(t, "APP_LEVEL", varErr.Key)
+	assert.EqualError(t, err, "APP_LEVEL: could not parse *env.textTestLevel: unknown level")
+}
+
+func TestWithText_NilDestination(t *testing.T) {
+	l := NewLoader(Map{"APP_LEVEL": "info"}).WithPrefix("APP_")
+
+	var varErr *VarError
+	err := l.WithText("LEVEL", nil)
+	assert.ErrorAs(t, err, &varErr)
+	assert.EqualError(t, err, "APP_LEVEL: could not parse <nil>: nil destination")
+
+	err = l.WithText("LEVEL", (*textTestLevel)(nil))
+	assert.ErrorAs(t, err, &varErr)
+	assert.EqualError(t, err, "APP_LEVEL: could not parse *env.textTestLevel: nil destination")
+}
+
+func TestGet_Unmarshalers(t *testing.T) {
+	l := NewLoader(Map{
+		"LEVEL":   "info",
+		"LEVELS":  "debug,error",
+		"REGIONS": "eu-west-1+us-east-1",
+		"VERSION": "v1.22",
+		"IP":      "10.0.0.1",
+		"PTR":     "debug",
+	})
+
+	level, err := GetFrom[textTestLevel](l, "LEVEL")
+	assert.NoError(t, err)
+	assert.Equal(t, textTestLevel(1), level)
+
+	levels, err := GetFrom[[]textTestLevel](l, "LEVELS")
+	assert.NoError(t, err)
+	assert.Equal(t, []textTestLevel{0, 2}, levels)
+
+	regions, err := GetFrom[textTestRegions](l, "REGIONS")
+	assert.NoError(t, err)
+	assert.Equal(t, textTestRegions{"eu-west-1", "us-east-1"}, regions)
+
+	version, err := GetFrom[textTestVersion](l, "VERSION")
+	assert.NoError(t, err)
+	assert.Equal(t, textTestVersion{Major: 1, Minor: 22}, version)
+
+	ip, err := GetFrom[net.IP](l, "IP")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", ip.String())
+
+	ptr, err := GetFrom[*textTestLevel](l, "PTR")
+	assert.NoError(t, err)
+	assert.Equal(t, textTestLevel(0), *ptr)
+}
+
+func TestBind_Unmarshalers(t *testing.T) {
+	l := NewLoader(Map{
+		"LEVEL":   "error",
+		"REGIONS": "eu-west-1",
+		"VERSION": "1.22",
+	})
+
+	var cfg struct {
+		Level   textTestLevel   `env:"LEVEL"`
+		Regions textTestRegions `env:"REGIONS"`
+		Version textTestVersion `env:"VERSION"`
+	}
+
+	err := l.Bind(&cfg)
+
+	assert.Equal(t, textTestLevel(2), cfg.Level)
+	assert.Equal(t, textTestRegions{"eu-west-1"}, cfg.Regions)
+	assert.ErrorContains(t, err, "could not bind Version: VERSION: could not parse env.textTestVersion")
+}
@@ -0,0 +1,298 @@
+package env
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var ErrInvalidValue = errors.New("invalid value")
+
+// validator checks a parsed value, and returns an error describing why it is invalid.
+type validator func(v any) error
+
+// validate adds the validator to the options, without altering the validators of
+// the options it may have been copied from.
+func (o *options) validate(v validator) {
+	o.validators = append(o.validators[:len(o.validators):len(o.validators)], v)
+}
+
+// validateCollections adds a validator applied to slice and map values as a whole,
+// before the validators applied to each of their elements.
+func (o *options) validateCollections(v validator) {
+	o.collectionValidators = append(o.collectionValidators[:len(o.collectionValidators):len(o.collectionValidators)], v)
+}
+
+// Min checks that the value is greater than or equal to min. It applies to
+// numbers, durations and strings, whatever the exact type of the value, e.g.
+// Min(1) can be given to WithInt64 or WithFloat32.
+//
+// Like every validator but NonZero, it is applied to each element of slice values
+// and to each value of map values, and ErrInvalidValue is returned if the check fails.
+func Min[T cmp.Ordered](min T) Option {
+	return func(o *options) {
+		o.validate(minValidator(min))
+	}
+}
+
+// Max checks that the value is less than or equal to max. See Min for the
+// supported types.
+func Max[T cmp.Ordered](max T) Option {
+	return func(o *options) {
+		o.validate(maxValidator(max))
+	}
+}
+
+// Between checks that the value is within the inclusive range [min, max]. See Min
+// for the supported types.
+func Between[T cmp.Ordered](min, max T) Option {
+	return func(o *options) {
+		o.validate(minValidator(min))
+		o.validate(maxValidator(max))
+	}
+}
+
+// OneOf checks that the value is equal to one of the given values. See Min for the
+// supported types, booleans are supported as well.
+func OneOf[T comparable](values ...T) Option {
+	allowed := make([]any, len(values))
+	for i, v := range values {
+		allowed[i] = v
+	}
+	return func(o *options) {
+		o.validate(oneOfValidator(allowed))
+	}
+}
+
+// Matches checks that the value matches the regular expression. Values that are
+// not strings are matched against their textual representation.
+func Matches(re *regexp.Regexp) Option {
+	return func(o *options) {
+		o.validate(func(v any) error {
+			if !re.MatchString(stringOf(v)) {
+				return fmt.Errorf("%w: must match %q", ErrInvalidValue, re.String())
+			}
+			return nil
+		})
+	}
+}
+
+// NonZero checks that the value is not the zero value of its type, e.g. 0 for
+// numbers or an empty string. Unlike other validators, it also applies to slice
+// and map values as a whole, which must not be empty, on top of each of their
+// elements.
+func NonZero() Option {
+	check := func(v any) error {
+		rv := reflect.ValueOf(v)
+		switch {
+		case (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map) && rv.Len() == 0:
+			return fmt.Errorf("%w: must not be empty", ErrInvalidValue)
+		case rv.IsZero():
+			return fmt.Errorf("%w: must not be zero", ErrInvalidValue)
+		}
+		return nil
+	}
+	return func(o *options) {
+		o.validate(check)
+		o.validateCollections(check)
+	}
+}
+
+// Check checks the value with a custom function, whose error is wrapped with
+// ErrInvalidValue. The value is converted to T if needed, e.g. a Check[int] can
+// be given to WithInt16.
+func Check[T any](fn func(T) error) Option {
+	return func(o *options) {
+		o.validate(func(v any) error {
+			rv, t := reflect.ValueOf(v), typeOf[T]()
+			if !rv.Type().ConvertibleTo(t) {
+				return fmt.Errorf("%w: cannot check %T with a func(%s) error", ErrInvalidValue, v, t)
+			}
+			if err := fn(rv.Convert(t).Interface().(T)); err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidValue, err)
+			}
+			return nil
+		})
+	}
+}
+
+func minValidator(min any) validator {
+	return func(v any) error {
+		c, err := compareValues(v, min)
+		if err != nil {
+			return err
+		}
+		if c < 0 {
+			return fmt.Errorf("%w: must be greater than or equal to %v", ErrInvalidValue, min)
+		}
+		return nil
+	}
+}
+
+func maxValidator(max any) validator {
+	return func(v any) error {
+		c, err := compareValues(v, max)
+		if err != nil {
+			return err
+		}
+		if c > 0 {
+			return fmt.Errorf("%w: must be less than or equal to %v", ErrInvalidValue, max)
+		}
+		return nil
+	}
+}
+
+func oneOfValidator(allowed []any) validator {
+	return func(v any) error {
+		for _, a := range allowed {
+			if c, err := compareValues(v, a); err == nil && c == 0 {
+				return nil
+			}
+		}
+
+		names := make([]string, len(allowed))
+		for i, a := range allowed {
+			names[i] = fmt.Sprint(a)
+		}
+		return fmt.Errorf("%w: must be one of %s", ErrInvalidValue, strings.Join(names, ", "))
+	}
+}
+
+// validate runs the validators against the parsed value, or against each of its
+// elements for slice and map values, which are first checked as a whole by the
+// collection validators.
+func validate(v any, validators, collectionValidators []validator) error {
+	if len(validators) == 0 && len(collectionValidators) == 0 {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice:
+		if err := validateValue(v, collectionValidators); err != nil {
+			return err
+		}
+		for i := 0; i < rv.Len(); i++ {
+			if err := validateValue(rv.Index(i).Interface(), validators); err != nil {
+				return fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+	case reflect.Map:
+		if err := validateValue(v, collectionValidators); err != nil {
+			return err
+		}
+		iter := rv.MapRange()
+		for iter.Next() {
+			if err := validateValue(iter.Value().Interface(), validators); err != nil {
+				return fmt.Errorf("key %v: %w", iter.Key(), err)
+			}
+		}
+	default:
+		return validateValue(v, validators)
+	}
+	return nil
+}
+
+// validateValue runs the validators against the parsed value as a whole, including
+// for slice and map values.
+func validateValue(v any, validators []validator) error {
+	for _, check := range validators {
+		if err := check(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compareValues compares two values of possibly different types, as long as they
+// are both numbers, both strings or both booleans. It returns -1, 0 or +1 like
+// cmp.Compare, with false being less than true.
+func compareValues(a, b any) (int, error) {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	ak, bk := kindClass(av), kindClass(bv)
+
+	switch {
+	case ak == reflect.Int && bk == reflect.Int:
+		return cmp.Compare(av.Int(), bv.Int()), nil
+	case ak == reflect.Uint && bk == reflect.Uint:
+		return cmp.Compare(av.Uint(), bv.Uint()), nil
+	case ak == reflect.Int && bk == reflect.Uint:
+		if av.Int() < 0 {
+			return -1, nil
+		}
+		return cmp.Compare(uint64(av.Int()), bv.Uint()), nil
+	case ak == reflect.Uint && bk == reflect.Int:
+		if bv.Int() < 0 {
+			return 1, nil
+		}
+		return cmp.Compare(av.Uint(), uint64(bv.Int())), nil
+	case isNumber(ak) && isNumber(bk):
+		return cmp.Compare(toFloat(av), toFloat(bv)), nil
+	case ak == reflect.String && bk == reflect.String:
+		return cmp.Compare(stringOf(a), stringOf(b)), nil
+	case ak == reflect.Bool && bk == reflect.Bool:
+		switch {
+		case av.Bool() == bv.Bool():
+			return 0, nil
+		case bv.Bool():
+			return -1, nil
+		default:
+			return 1, nil
+		}
+	default:
+		return 0, fmt.Errorf("%w: cannot compare %T with %T", ErrInvalidValue, a, b)
+	}
+}
+
+// kindClass returns the kind of the value, with every signed integer kind reported
+// as reflect.Int, every unsigned one as reflect.Uint, every float one as reflect.Float64,
+// and Secret values as reflect.String.
+func kindClass(v reflect.Value) reflect.Kind {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflect.Int
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	}
+	if v.Type() == secretType {
+		return reflect.String
+	}
+	return v.Kind()
+}
+
+func isNumber(k reflect.Kind) bool {
+	return k == reflect.Int || k == reflect.Uint || k == reflect.Float64
+}
+
+func toFloat(v reflect.Value) float64 {
+	switch kindClass(v) {
+	case reflect.Int:
+		return float64(v.Int())
+	case reflect.Uint:
+		return float64(v.Uint())
+	default:
+		return v.Float()
+	}
+}
+
+// stringOf returns the textual representation of a value, revealing secrets.
+func stringOf(v any) string {
+	switch v := v.(type) {
+	case Secret:
+		return v.Reveal()
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		return rv.String()
+	}
+	return fmt.Sprint(v)
+}
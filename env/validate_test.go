@@ -0,0 +1,175 @@
+package env
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/thomasgouveia/goutils/env/testutils"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestValidators(t *testing.T) {
+	l := NewLoader(Map{
+		"WORKERS":  "8",
+		"RATIO":    "0.75",
+		"TIMEOUT":  "30s",
+		"LEVEL":    "info",
+		"PORTS":    "80,443,8080",
+		"LIMITS":   "a=1,b=0",
+		"ZERO":     "0",
+		"NEGATIVE": "-1",
+	})
+
+	cases := []testutils.TestCase[Option]{
+		{Name: "Min accepts a greater value", Given: "WORKERS", Expected: Min(1)},
+		{Name: "Min compares values of different types", Given: "RATIO", Expected: Min(0)},
+		{Name: "Min rejects a lower value", Given: "WORKERS", Expected: Min(16), ShouldFail: true},
+		{Name: "Min compares signed and unsigned values", Given: "NEGATIVE", Expected: Min(uint(0)), ShouldFail: true},
+		{Name: "Max accepts an equal value", Given: "WORKERS", Expected: Max(8)},
+		{Name: "Max rejects a greater value", Given: "RATIO", Expected: Max(0.5), ShouldFail: true},
+		{Name: "Between accepts durations in range", Given: "TIMEOUT", Expected: Between(time.Second, time.Minute)},
+		{Name: "Between rejects durations out of range", Given: "TIMEOUT", Expected: Between(time.Second, 10*time.Second), ShouldFail: true},
+		{Name: "OneOf accepts an allowed value", Given: "LEVEL", Expected: OneOf("debug", "info", "warn")},
+		{Name: "OneOf rejects other values", Given: "LEVEL", Expected: OneOf("debug", "warn"), ShouldFail: true},
+		{Name: "Matches accepts a matching value", Given: "LEVEL", Expected: Matches(regexp.MustCompile(`^[a-z]+$`))},
+		{Name: "Matches rejects other values", Given: "WORKERS", Expected: Matches(regexp.MustCompile(`^[a-z]+$`)), ShouldFail: true},
+		{Name: "NonZero accepts a non-zero value", Given: "WORKERS", Expected: NonZero()},
+		{Name: "NonZero rejects a zero value", Given: "ZERO", Expected: NonZero(), ShouldFail: true},
+		{Name: "validators apply to each element of slices", Given: "PORTS", Expected: Max(1024), ShouldFail: true},
+		{Name: "validators apply to each value of maps", Given: "LIMITS", Expected: NonZero(), ShouldFail: true},
+		{Name: "Check accepts a value the function accepts", Given: "WORKERS", Expected: Check(func(n int) error { return nil })},
+		{Name: "Check rejects a value the function rejects", Given: "WORKERS", Expected: Check(func(n int) error { return errors.New("must be odd") }), ShouldFail: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			var err error
+			switch tc.Given {
+			case "RATIO":
+				_, err = l.WithFloat64(tc.Given, tc.Expected)
+			case "TIMEOUT":
+				_, err = l.WithDuration(tc.Given, tc.Expected)
+			case "LEVEL":
+				_, err = l.WithString(tc.Given, tc.Expected)
+			case "PORTS":
+				_, err = l.WithIntSlice(tc.Given, tc.Expected)
+			case "LIMITS":
+				_, err = l.WithIntMap(tc.Given, tc.Expected)
+			default:
+				_, err = l.WithInt64(tc.Given, tc.Expected)
+			}
+
+			if tc.ShouldFail {
+				assert.ErrorIs(t, err, ErrInvalidValue)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidators_Error(t *testing.T) {
+	l := NewLoader(Map{
+		"WORKERS": "0",
+		"PORTS":   "80,70000",
+		"TOKEN":   "abc",
+	})
+
+	_, err := l.WithInt("WORKERS", Between(1, 64))
+	assert.EqualError(t, err, "WORKERS: invalid value: must be greater than or equal to 1")
+
+	var varErr *VarError
+	assert.ErrorAs(t, err, &varErr)
+	assert.Equal(t, "WORKERS", varErr.Key)
+	assert.Equal(t, "0", varErr.Value)
+
+	_, err = l.WithUintSlice("PORTS", Max(65535))
+	assert.EqualError(t, err, "PORTS: element 1: invalid value: must be less than or equal to 65535")
+
+	_, err = l.WithSecret("TOKEN", Matches(regexp.MustCompile(`^\d+$`)))
+	assert.ErrorIs(t, err, ErrInvalidValue)
+	assert.NotContains(t, err.Error(), "abc")
+
+	assert.Equal(t, 4, l.WithDefaultInt("WORKERS", 4, NonZero()))
+}
+
+func TestValidators_DoNotLeakBetweenCalls(t *testing.T) {
+	l := NewLoader(Map{"WORKERS": "8"}, Min(1))
+
+	_, err := l.WithInt("WORKERS", Max(4))
+	assert.ErrorIs(t, err, ErrInvalidValue)
+
+	workers, err := l.WithInt("WORKERS", OneOf(8, 16))
+	assert.NoError(t, err)
+	assert.Equal(t, 8, workers)
+}
+
+func TestBind_Validators(t *testing.T) {
+	l := NewLoader(Map{
+		"WORKERS": "0",
+		"TIMEOUT": "2m",
+		"LEVEL":   "trace",
+		"NAME":    "billing,api",
+		"PORTS":   "80,443",
+	})
+
+	var cfg struct {
+		Workers int           `env:"WORKERS,min=1,max=64"`
+		Timeout time.Duration `env:"TIMEOUT,max=1m"`
+		Level   string        `env:"LEVEL,oneof=debug info warn"`
+		Name    string        `env:"NAME,nonzero,match=^[a-z]+(,[a-z]+)*$"`
+		Ports   []uint16      `env:"PORTS,min=1"`
+	}
+
+	err := l.Bind(&cfg)
+
+	var errs Errors
+	assert.ErrorAs(t, err, &errs)
+	assert.Len(t, errs, 3)
+	assert.ErrorIs(t, err, ErrInvalidValue)
+	assert.ErrorContains(t, err, "could not bind Workers: WORKERS: invalid value: must be greater than or equal to 1")
+	assert.ErrorContains(t, err, "could not bind Timeout: TIMEOUT: invalid value: must be less than or equal to 1m0s")
+	assert.ErrorContains(t, err, "could not bind Level: LEVEL: invalid value: must be one of debug, info, warn")
+	assert.Equal(t, "billing,api", cfg.Name)
+	assert.Equal(t, []uint16{80, 443}, cfg.Ports)
+}
+
+func TestBind_InvalidValidatorTag(t *testing.T) {
+	t.Setenv("WORKERS", "8")
+
+	var cfg struct {
+		Workers int `env:"WORKERS,min=one"`
+	}
+
+	assert.ErrorContains(t, Bind(&cfg), "invalid tag option min=one")
+}
+
+func TestNonZero_Collections(t *testing.T) {
+	l := NewLoader(Map{
+		"BLANK":   " , ",
+		"HOSTS":   "a,b",
+		"LIMITS":  "a=1",
+		"NOTHING": "",
+	})
+
+	_, err := l.WithStringSlice("BLANK", SkipEmpty(), NonZero())
+	assert.ErrorIs(t, err, ErrInvalidValue)
+	assert.EqualError(t, err, "BLANK: invalid value: must not be empty")
+
+	_, err = l.WithIntMap("NOTHING", AllowEmpty(), NonZero())
+	assert.ErrorIs(t, err, ErrInvalidValue)
+
+	hosts, err := l.WithStringSlice("HOSTS", NonZero())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, hosts)
+
+	limits, err := l.WithIntMap("LIMITS", NonZero())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1}, limits)
+
+	// Other validators still only apply to the elements, so an empty slice passes them.
+	empty, err := l.WithIntSlice("BLANK", SkipEmpty(), Min(1))
+	assert.NoError(t, err)
+	assert.Empty(t, empty)
+}